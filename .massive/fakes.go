@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// This program generates fakes of the REST client interfaces in interfaces.go of the rest package. It's run by
+// go generate in the massivetest package: go run ../.massive/fakes.go ../rest fakes.go
+func main() {
+	if len(os.Args) < 3 {
+		log.Fatal("expected the rest package directory and the output file")
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join(os.Args[1], "interfaces.go"), nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The standard library packages used by the interfaces (e.g. context) are imported as is.
+	var std strings.Builder
+	for _, imp := range file.Imports {
+		if !strings.Contains(imp.Path.Value, ".") {
+			fmt.Fprintf(&std, "\t%s\n", imp.Path.Value)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `// Code generated by .massive/fakes.go; DO NOT EDIT.
+
+package massivetest
+
+import (
+%s
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+`, std.String())
+
+	var fakes []string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || ts.Name.Name == "API" {
+				continue
+			}
+			name := "Fake" + strings.TrimSuffix(ts.Name.Name, "API")
+			fakes = append(fakes, name)
+			writeFake(&buf, fset, name, ts.Name.Name, it)
+		}
+	}
+	writeClient(&buf, fakes)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format generated code: %v\n%s", err, buf.String())
+	}
+	if err := os.WriteFile(os.Args[2], src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeFake(buf *bytes.Buffer, fset *token.FileSet, name, iface string, it *ast.InterfaceType) {
+	fmt.Fprintf(buf, "\n// %s is a fake massive.%s. Its methods call the function fields of the same name with a Func\n", name, iface)
+	fmt.Fprintf(buf, "// suffix and return ErrNotImplemented if they're nil.\ntype %s struct {\n", name)
+	for _, m := range it.Methods.List {
+		fmt.Fprintf(buf, "\t%sFunc %s\n", m.Names[0].Name, node(fset, m.Type))
+	}
+	buf.WriteString("}\n")
+
+	for _, m := range it.Methods.List {
+		method := m.Names[0].Name
+		ft := m.Type.(*ast.FuncType)
+
+		var args []string
+		for _, p := range ft.Params.List {
+			for _, n := range p.Names {
+				if _, variadic := p.Type.(*ast.Ellipsis); variadic {
+					args = append(args, n.Name+"...")
+				} else {
+					args = append(args, n.Name)
+				}
+			}
+		}
+
+		var missing string
+		notImplemented := fmt.Sprintf("notImplemented(%q)", iface+"."+method)
+		if len(ft.Results.List) == 1 {
+			missing = fmt.Sprintf("Iter[%s](nil, %s)", node(fset, ft.Results.List[0].Type.(*ast.StarExpr).X.(*ast.IndexExpr).Index), notImplemented)
+		} else {
+			missing = "nil, " + notImplemented
+		}
+
+		fmt.Fprintf(buf, "\n// %s calls %sFunc.\n", method, method)
+		fmt.Fprintf(buf, "func (f *%s) %s%s {\n", name, method, strings.TrimPrefix(node(fset, ft), "func"))
+		fmt.Fprintf(buf, "\tif f.%sFunc == nil {\n\t\treturn %s\n\t}\n", method, missing)
+		fmt.Fprintf(buf, "\treturn f.%sFunc(%s)\n}\n", method, strings.Join(args, ", "))
+	}
+}
+
+func writeClient(buf *bytes.Buffer, fakes []string) {
+	buf.WriteString("\n// FakeClient is a fake massive.API that embeds the fakes of the sub-client interfaces. The experimental\n")
+	buf.WriteString("// methods are faked by the VX field.\ntype FakeClient struct {\n")
+	for _, f := range fakes {
+		if f == "FakeVX" {
+			continue
+		}
+		fmt.Fprintf(buf, "\t%s\n", f)
+	}
+	buf.WriteString("\tVX FakeVX\n}\n\nvar (\n\t_ massive.API = (*FakeClient)(nil)\n")
+	for _, f := range fakes {
+		fmt.Fprintf(buf, "\t_ massive.%sAPI = (*%s)(nil)\n", strings.TrimPrefix(f, "Fake"), f)
+	}
+	buf.WriteString(")\n")
+}
+
+func node(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, n); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
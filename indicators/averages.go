@@ -0,0 +1,102 @@
+package indicators
+
+import (
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// SMA calculates the simple moving average of a series, like GetSMA.
+type SMA struct {
+	series models.SeriesType
+	values *window
+	sum    float64
+}
+
+// NewSMA returns a calculator of the simple moving average of the given price (e.g. models.Close) over a window of
+// aggregates. Windows less than 1 are treated as 1.
+func NewSMA(window int, series models.SeriesType) *SMA {
+	return &SMA{series: series, values: newWindow(window)}
+}
+
+// Update implements Indicator. The first value is at the window's last aggregate.
+func (s *SMA) Update(agg models.Agg) (models.SingleIndicatorValue, bool) {
+	v := price(agg, s.series)
+	s.sum += v
+	if old, ok := s.values.push(v); ok {
+		s.sum -= old
+	}
+	if !s.values.full {
+		return models.SingleIndicatorValue{}, false
+	}
+	return models.SingleIndicatorValue{Timestamp: agg.Timestamp, Value: s.sum / float64(len(s.values.values))}, true
+}
+
+// EMA calculates the exponential moving average of a series, like GetEMA.
+type EMA struct {
+	series models.SeriesType
+	ema    ema
+}
+
+// NewEMA returns a calculator of the exponential moving average of the given price (e.g. models.Close) over a
+// window of aggregates. The average is seeded with the simple moving average of the first window. Windows less than
+// 1 are treated as 1.
+func NewEMA(window int, series models.SeriesType) *EMA {
+	return &EMA{series: series, ema: newEMA(window)}
+}
+
+// Update implements Indicator. The first value is at the window's last aggregate.
+func (e *EMA) Update(agg models.Agg) (models.SingleIndicatorValue, bool) {
+	v, ok := e.ema.update(price(agg, e.series))
+	if !ok {
+		return models.SingleIndicatorValue{}, false
+	}
+	return models.SingleIndicatorValue{Timestamp: agg.Timestamp, Value: v}, true
+}
+
+// ema is an exponential moving average of values.
+type ema struct {
+	window int
+	alpha  float64
+	n      int
+	value  float64
+}
+
+func newEMA(window int) ema {
+	window = max(window, 1)
+	return ema{window: window, alpha: 2 / float64(window+1)}
+}
+
+func (e *ema) update(v float64) (float64, bool) {
+	e.n++
+	switch {
+	case e.n < e.window:
+		e.value += v
+		return 0, false
+	case e.n == e.window:
+		e.value = (e.value + v) / float64(e.window)
+	default:
+		e.value += e.alpha * (v - e.value)
+	}
+	return e.value, true
+}
+
+// wilder is a moving average with Wilder's smoothing, which RSI and ATR use. It's seeded with the simple moving
+// average of the first window.
+type wilder struct {
+	window int
+	n      int
+	value  float64
+}
+
+func (w *wilder) update(v float64) (float64, bool) {
+	w.n++
+	switch {
+	case w.n < w.window:
+		w.value += v
+		return 0, false
+	case w.n == w.window:
+		w.value = (w.value + v) / float64(w.window)
+	default:
+		w.value = (w.value*float64(w.window-1) + v) / float64(w.window)
+	}
+	return w.value, true
+}
@@ -0,0 +1,122 @@
+// Package indicators computes technical indicators (e.g. moving averages, RSI and MACD) locally from aggregates, as
+// an alternative to the indicator endpoints of the REST API that works for any number of tickers and windows and on
+// live data. Values have the same shape as the results of the REST API (e.g. models.SingleIndicatorValue).
+//
+// Indicators are computed incrementally: each one is a calculator that's updated with the aggregates of a series in
+// ascending timestamp order (the default order of ListAggs). Compute applies a calculator to a slice of aggregates:
+//
+//	values := indicators.Compute(aggs, indicators.NewRSI(14, models.Close))
+//
+// and Seq to a sequence, e.g. the results of ListAggs:
+//
+//	for v, err := range indicators.Seq(c.ListAggs(ctx, params).All(), indicators.NewSMA(50, models.Close)) {
+//		if err != nil {
+//			return err
+//		}
+//		log.Print(v) // do something with the current value
+//	}
+//
+// Unlike the REST API, which returns the newest values first by default, values are returned oldest first. A
+// calculator keeps the state of one series, so separate series (e.g. tickers) need separate calculators. Stream
+// keeps a calculator per symbol up to date with live aggregates, e.g. from the WebSocket client.
+package indicators
+
+import (
+	goiter "iter"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// Indicator is a technical indicator calculator. Update adds the next aggregate of a series and returns the value
+// of the indicator at it, or false while the indicator doesn't have enough aggregates yet (e.g. fewer than the
+// window of a moving average).
+type Indicator[V any] interface {
+	Update(agg models.Agg) (V, bool)
+}
+
+var (
+	_ Indicator[models.SingleIndicatorValue] = (*SMA)(nil)
+	_ Indicator[models.SingleIndicatorValue] = (*EMA)(nil)
+	_ Indicator[models.SingleIndicatorValue] = (*RSI)(nil)
+	_ Indicator[models.MACDIndicatorValue]   = (*MACD)(nil)
+	_ Indicator[BollingerBandsValue]         = (*BollingerBands)(nil)
+	_ Indicator[models.SingleIndicatorValue] = (*ATR)(nil)
+	_ Indicator[models.SingleIndicatorValue] = (*VWAP)(nil)
+	_ Indicator[StochasticValue]             = (*Stochastic)(nil)
+	_ Indicator[models.SingleIndicatorValue] = (*OBV)(nil)
+)
+
+// Compute updates the indicator with the aggregates and returns its values, oldest first. The first values are
+// missing if the indicator needs several aggregates to compute a value.
+func Compute[V any](aggs []models.Agg, ind Indicator[V]) []V {
+	values := make([]V, 0, len(aggs))
+	for _, agg := range aggs {
+		if v, ok := ind.Update(agg); ok {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// Seq returns a sequence of the values of the indicator as it's updated with a sequence of aggregates, e.g. the
+// results of ListAggs. Errors of the aggregates are yielded as is.
+func Seq[V any](aggs goiter.Seq2[models.Agg, error], ind Indicator[V]) goiter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for agg, err := range aggs {
+			if err != nil {
+				var zero V
+				yield(zero, err)
+				return
+			}
+			if v, ok := ind.Update(agg); ok {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// price returns the price of an aggregate that a series type refers to. It defaults to the close price.
+func price(agg models.Agg, series models.SeriesType) float64 {
+	switch series {
+	case models.Open:
+		return agg.Open
+	case models.High:
+		return agg.High
+	case models.Low:
+		return agg.Low
+	}
+	return agg.Close
+}
+
+// window holds the last values of a series, up to its size.
+type window struct {
+	values []float64
+	next   int
+	full   bool
+}
+
+func newWindow(size int) *window {
+	return &window{values: make([]float64, max(size, 1))}
+}
+
+// push adds a value and returns the value that it replaced once the window is full.
+func (w *window) push(v float64) (float64, bool) {
+	old, evicted := w.values[w.next], w.full
+	w.values[w.next] = v
+	w.next = (w.next + 1) % len(w.values)
+	if w.next == 0 {
+		w.full = true
+	}
+	return old, evicted
+}
+
+// bounds returns the lowest and highest values of the window.
+func (w *window) bounds() (float64, float64) {
+	lo, hi := w.values[0], w.values[0]
+	for _, v := range w.values[1:] {
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	return lo, hi
+}
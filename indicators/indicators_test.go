@@ -0,0 +1,207 @@
+package indicators_test
+
+import (
+	"encoding/json"
+	"errors"
+	goiter "iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/massive-com/client-go/v2/indicators"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const delta = 1e-9
+
+// The fixtures in testdata are responses of GetSMA (window 5), GetEMA (window 10), GetRSI (window 14) and GetMACD
+// (windows 3, 6 and 4) for the same daily aggregates, whose close prices are the classic example series of Wilder's
+// RSI. Their values were computed independently from the aggregates and are newest first, like the server returns
+// them.
+
+// loadResponse decodes a fixture into a response.
+func loadResponse(t *testing.T, name string, res any) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(data, res))
+}
+
+func aggs(t *testing.T) []models.Agg {
+	var res models.GetSMAResponse
+	loadResponse(t, "sma.json", &res)
+	require.Len(t, res.Results.Underlying.Aggregates, 30)
+	return res.Results.Underlying.Aggregates
+}
+
+func assertValues(t *testing.T, aggs []models.Agg, values []models.SingleIndicatorValue, first int, expect map[int]float64) {
+	t.Helper()
+	require.Len(t, values, len(aggs)-first)
+	for i, v := range expect {
+		assert.Equal(t, aggs[i].Timestamp, values[i-first].Timestamp)
+		assert.InDelta(t, v, values[i-first].Value, delta, "value at aggregate %d", i)
+	}
+}
+
+func TestSMA(t *testing.T) {
+	aggs := aggs(t)
+	values := indicators.Compute(aggs, indicators.NewSMA(5, models.Close))
+	assertValues(t, aggs, values, 4, map[int]float64{4: 44.104, 5: 44.202, 6: 44.404, 29: 44.572})
+
+	values = indicators.Compute(aggs, indicators.NewSMA(1, models.Open))
+	assertValues(t, aggs, values, 0, map[int]float64{0: 44.34, 1: 44.34, 29: 44.48})
+}
+
+func TestEMA(t *testing.T) {
+	aggs := aggs(t)
+	values := indicators.Compute(aggs, indicators.NewEMA(10, models.Close))
+	assertValues(t, aggs, values, 9, map[int]float64{9: 44.779, 10: 44.981, 29: 45.06135420391587})
+}
+
+func TestRSI(t *testing.T) {
+	aggs := aggs(t)
+	values := indicators.Compute(aggs, indicators.NewRSI(14, models.Close))
+	assertValues(t, aggs, values, 14, map[int]float64{14: 70.46413502109705, 15: 66.24961855355505, 16: 66.48094183471265, 29: 44.08492128169264})
+
+	flat := []models.Agg{{Close: 1}, {Close: 1}, {Close: 1}, {Close: 2}}
+	values = indicators.Compute(flat, indicators.NewRSI(2, models.Close))
+	assert.Equal(t, []float64{50, 100}, []float64{values[0].Value, values[1].Value})
+}
+
+func TestMACD(t *testing.T) {
+	aggs := aggs(t)
+	values := indicators.Compute(aggs, indicators.NewMACD(3, 6, 4, models.Close))
+	require.Len(t, values, 22)
+	for i, expect := range map[int]models.MACDIndicatorValue{
+		0:  {Timestamp: aggs[8].Timestamp, Value: 0.41375744047618923, Signal: 0.33284040178571495, Histogram: 0.08091703869047429},
+		1:  {Timestamp: aggs[9].Timestamp, Value: 0.4259093324829877, Signal: 0.370067974064624, Histogram: 0.055841358418363685},
+		21: {Timestamp: aggs[29].Timestamp, Value: -0.2673662893254658, Signal: -0.293528798665446, Histogram: 0.02616250933998021},
+	} {
+		assert.Equal(t, expect.Timestamp, values[i].Timestamp)
+		assert.InDelta(t, expect.Value, values[i].Value, delta)
+		assert.InDelta(t, expect.Signal, values[i].Signal, delta)
+		assert.InDelta(t, expect.Histogram, values[i].Histogram, delta)
+	}
+}
+
+func TestComputeMatchesResponses(t *testing.T) {
+	singles := map[string]indicators.Indicator[models.SingleIndicatorValue]{
+		"sma.json": indicators.NewSMA(5, models.Close),
+		"ema.json": indicators.NewEMA(10, models.Close),
+		"rsi.json": indicators.NewRSI(14, models.Close),
+	}
+	for name, ind := range singles {
+		t.Run(name, func(t *testing.T) {
+			var res struct {
+				Results models.SingleIndicatorResults `json:"results"`
+			}
+			loadResponse(t, name, &res)
+			expect := slices.Clone(res.Results.Values)
+			slices.Reverse(expect)
+
+			values := indicators.Compute(res.Results.Underlying.Aggregates, ind)
+			require.Len(t, values, len(expect))
+			for i := range expect {
+				assert.Equal(t, expect[i].Timestamp, values[i].Timestamp)
+				assert.InDelta(t, expect[i].Value, values[i].Value, delta, "value %d", i)
+			}
+		})
+	}
+
+	t.Run("macd.json", func(t *testing.T) {
+		var res models.GetMACDResponse
+		loadResponse(t, "macd.json", &res)
+		expect := slices.Clone(res.Results.Values)
+		slices.Reverse(expect)
+
+		values := indicators.Compute(res.Results.Underlying.Aggregates, indicators.NewMACD(3, 6, 4, models.Close))
+		require.Len(t, values, len(expect))
+		for i := range expect {
+			assert.Equal(t, expect[i].Timestamp, values[i].Timestamp)
+			assert.InDelta(t, expect[i].Value, values[i].Value, delta, "value %d", i)
+			assert.InDelta(t, expect[i].Signal, values[i].Signal, delta, "signal %d", i)
+			assert.InDelta(t, expect[i].Histogram, values[i].Histogram, delta, "histogram %d", i)
+		}
+	})
+}
+
+func TestBollingerBands(t *testing.T) {
+	aggs := aggs(t)
+	values := indicators.Compute(aggs, indicators.NewBollingerBands(5, 2, models.Close))
+	require.Len(t, values, 26)
+	assert.Equal(t, aggs[4].Timestamp, values[0].Timestamp)
+	assert.InDelta(t, 44.104, values[0].Middle, delta)
+	assert.InDelta(t, 44.63550352773994, values[0].Upper, delta)
+	assert.InDelta(t, 43.572496472260056, values[0].Lower, delta)
+	assert.InDelta(t, 44.572, values[25].Middle, delta)
+	assert.InDelta(t, 45.431246181254245, values[25].Upper, delta)
+	assert.InDelta(t, 43.71275381874576, values[25].Lower, delta)
+}
+
+func TestATR(t *testing.T) {
+	aggs := aggs(t)
+	values := indicators.Compute(aggs, indicators.NewATR(5))
+	assertValues(t, aggs, values, 5, map[int]float64{5: 0.994, 6: 0.9592, 29: 0.9848835361009118})
+}
+
+func TestVWAP(t *testing.T) {
+	aggs := aggs(t)
+	vwap := indicators.NewVWAP()
+	values := indicators.Compute(aggs, vwap)
+	assertValues(t, aggs, values, 0, map[int]float64{0: 44.35666666666666, 1: 44.253509344946465, 29: 45.489199802570376})
+
+	// the VWAP of an aggregate is used when it has one
+	vwap.Reset()
+	_, ok := vwap.Update(models.Agg{Close: 10})
+	assert.False(t, ok)
+	v, ok := vwap.Update(models.Agg{Close: 10, VWAP: 12, Volume: 100})
+	assert.True(t, ok)
+	assert.Equal(t, 12.0, v.Value)
+}
+
+func TestStochastic(t *testing.T) {
+	aggs := aggs(t)
+	values := indicators.Compute(aggs, indicators.NewStochastic(5, 3))
+	require.Len(t, values, 24)
+	assert.Equal(t, aggs[6].Timestamp, values[0].Timestamp)
+	assert.InDelta(t, 88.03827751196174, values[0].K, delta)
+	assert.InDelta(t, 82.44844757645096, values[0].D, delta)
+	assert.InDelta(t, 87.80487804878061, values[1].K, delta)
+	assert.InDelta(t, 85.87132963135855, values[1].D, delta)
+	assert.InDelta(t, 32.083333333333485, values[23].K, delta)
+	assert.InDelta(t, 28.819338314371418, values[23].D, delta)
+}
+
+func TestOBV(t *testing.T) {
+	aggs := aggs(t)
+	values := indicators.Compute(aggs, indicators.NewOBV())
+	assertValues(t, aggs, values, 0, map[int]float64{0: 0, 1: -1137, 2: 137, 29: -3591})
+}
+
+func TestSeq(t *testing.T) {
+	aggs := aggs(t)
+	errFailed := errors.New("failed")
+	seq := func(yield func(models.Agg, error) bool) {
+		for _, agg := range aggs[:6] {
+			if !yield(agg, nil) {
+				return
+			}
+		}
+		yield(models.Agg{}, errFailed)
+	}
+
+	var values []models.SingleIndicatorValue
+	var err error
+	for v, e := range indicators.Seq(goiter.Seq2[models.Agg, error](seq), indicators.NewSMA(5, models.Close)) {
+		if e != nil {
+			err = e
+			break
+		}
+		values = append(values, v)
+	}
+	assert.ErrorIs(t, err, errFailed)
+	assertValues(t, aggs[:6], values, 4, map[int]float64{4: 44.104, 5: 44.202})
+}
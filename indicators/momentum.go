@@ -0,0 +1,132 @@
+package indicators
+
+import (
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// RSI calculates the relative strength index of a series, like GetRSI.
+type RSI struct {
+	series     models.SeriesType
+	prev       float64
+	started    bool
+	gain, loss wilder
+}
+
+// NewRSI returns a calculator of the relative strength index of the given price (e.g. models.Close) over a window
+// of price changes, with Wilder's smoothing of the average gains and losses. Windows less than 1 are treated as 1.
+func NewRSI(window int, series models.SeriesType) *RSI {
+	window = max(window, 1)
+	return &RSI{series: series, gain: wilder{window: window}, loss: wilder{window: window}}
+}
+
+// Update implements Indicator. The first value is at the aggregate after the window's last one, since the window
+// is made of the changes between aggregates. The index is 100 if there were no losses, and 50 if the price didn't
+// change at all.
+func (r *RSI) Update(agg models.Agg) (models.SingleIndicatorValue, bool) {
+	v := price(agg, r.series)
+	if !r.started {
+		r.prev, r.started = v, true
+		return models.SingleIndicatorValue{}, false
+	}
+
+	change := v - r.prev
+	r.prev = v
+	gain, ok := r.gain.update(max(change, 0))
+	loss, _ := r.loss.update(max(-change, 0))
+	if !ok {
+		return models.SingleIndicatorValue{}, false
+	}
+
+	value := 100.0
+	switch {
+	case gain == 0 && loss == 0:
+		value = 50
+	case loss != 0:
+		value = 100 - 100/(1+gain/loss)
+	}
+	return models.SingleIndicatorValue{Timestamp: agg.Timestamp, Value: value}, true
+}
+
+// MACD calculates the moving average convergence/divergence of a series, like GetMACD.
+type MACD struct {
+	series              models.SeriesType
+	short, long, signal ema
+}
+
+// NewMACD returns a calculator of the moving average convergence/divergence of the given price (e.g. models.Close),
+// i.e. the difference between its short and long exponential moving averages, along with the exponential moving
+// average of the difference over the signal window. The REST API's defaults are 12, 26 and 9. Windows less than 1
+// are treated as 1.
+func NewMACD(shortWindow, longWindow, signalWindow int, series models.SeriesType) *MACD {
+	return &MACD{series: series, short: newEMA(shortWindow), long: newEMA(longWindow), signal: newEMA(signalWindow)}
+}
+
+// Update implements Indicator. The first value is at the aggregate where the signal has a value, i.e. after
+// longWindow+signalWindow-1 aggregates.
+func (m *MACD) Update(agg models.Agg) (models.MACDIndicatorValue, bool) {
+	v := price(agg, m.series)
+	short, shortOK := m.short.update(v)
+	long, longOK := m.long.update(v)
+	if !shortOK || !longOK {
+		return models.MACDIndicatorValue{}, false
+	}
+
+	value := short - long
+	signal, ok := m.signal.update(value)
+	if !ok {
+		return models.MACDIndicatorValue{}, false
+	}
+	return models.MACDIndicatorValue{Timestamp: agg.Timestamp, Value: value, Signal: signal, Histogram: value - signal}, true
+}
+
+// StochasticValue is a value of the stochastic oscillator.
+type StochasticValue struct {
+	Timestamp models.Millis `json:"timestamp,omitempty"`
+
+	// K is the position of the close price within the range of the window, from 0 (the lowest low) to 100 (the
+	// highest high).
+	K float64 `json:"k,omitempty"`
+
+	// D is the simple moving average of K.
+	D float64 `json:"d,omitempty"`
+}
+
+// Stochastic calculates the stochastic oscillator of a series.
+type Stochastic struct {
+	highs, lows *window
+	k           *window
+	sum         float64
+}
+
+// NewStochastic returns a calculator of the stochastic oscillator, whose %K is the position of the close price
+// within the high-low range of kWindow aggregates and %D is its simple moving average over dWindow aggregates
+// (commonly 14 and 3). Windows less than 1 are treated as 1.
+func NewStochastic(kWindow, dWindow int) *Stochastic {
+	return &Stochastic{highs: newWindow(kWindow), lows: newWindow(kWindow), k: newWindow(dWindow)}
+}
+
+// Update implements Indicator. The first value is after kWindow+dWindow-1 aggregates. %K is 50 if the range is
+// empty, i.e. the highest high and the lowest low of the window are equal.
+func (s *Stochastic) Update(agg models.Agg) (StochasticValue, bool) {
+	s.highs.push(agg.High)
+	s.lows.push(agg.Low)
+	if !s.highs.full {
+		return StochasticValue{}, false
+	}
+
+	_, high := s.highs.bounds()
+	low, _ := s.lows.bounds()
+	k := 50.0
+	if high != low {
+		k = 100 * (agg.Close - low) / (high - low)
+	}
+
+	s.sum += k
+	if old, ok := s.k.push(k); ok {
+		s.sum -= old
+	}
+	if !s.k.full {
+		return StochasticValue{}, false
+	}
+	return StochasticValue{Timestamp: agg.Timestamp, K: k, D: s.sum / float64(len(s.k.values))}, true
+}
@@ -0,0 +1,169 @@
+package indicators
+
+import (
+	"context"
+	goiter "iter"
+	"sync"
+	"time"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+	wsmodels "github.com/massive-com/client-go/v2/websocket/models"
+)
+
+// SymbolValue is a value of an indicator for a symbol.
+type SymbolValue[V any] struct {
+	Symbol string
+	Value  V
+}
+
+// Stream keeps an indicator per symbol up to date with live aggregates, e.g. the minute aggregates of a WebSocket
+// client, so that indicators don't have to be recomputed (or requested from the REST API) for every new aggregate.
+// The indicator of a symbol is seeded with its history and then updated with every new aggregate:
+//
+//	s := indicators.NewStream(func() indicators.Indicator[models.SingleIndicatorValue] {
+//		return indicators.NewEMA(20, models.Close)
+//	})
+//	if err := s.Seed("AAPL", c.ListAggs(ctx, params).All()); err != nil {
+//		return err
+//	}
+//	for v := range s.Run(ctx, ws.Output(), "AM") {
+//		log.Print(v.Symbol, v.Value) // do something with the updated value
+//	}
+//
+// Aggregates that aren't newer than the last aggregate of their symbol (e.g. a bar that's also the last one of the
+// history, or one that's delivered again after a reconnect) are ignored, so the history should end with a complete
+// aggregate. A Stream is safe for concurrent use.
+type Stream[V any] struct {
+	newIndicator func() Indicator[V]
+
+	mu      sync.Mutex
+	symbols map[string]*symbolState[V]
+}
+
+// symbolState is the indicator of a symbol along with its latest aggregate and value.
+type symbolState[V any] struct {
+	indicator Indicator[V]
+	last      time.Time
+	value     V
+	ok        bool
+}
+
+// NewStream returns a stream that creates an indicator for each symbol with newIndicator.
+func NewStream[V any](newIndicator func() Indicator[V]) *Stream[V] {
+	return &Stream[V]{newIndicator: newIndicator, symbols: make(map[string]*symbolState[V])}
+}
+
+// Seed updates a new indicator for the symbol with its history, e.g. the results of ListAggs in ascending order,
+// and replaces the symbol's indicator with it. It stops at the first error of the history and returns it, leaving
+// the symbol's indicator as it was. Symbols should be seeded before live aggregates are added, since the aggregates
+// that are added while a symbol is seeded are lost. Symbols that aren't seeded start with a new indicator.
+func (s *Stream[V]) Seed(symbol string, history goiter.Seq2[models.Agg, error]) error {
+	state := &symbolState[V]{indicator: s.newIndicator()}
+	for agg, err := range history {
+		if err != nil {
+			return err
+		}
+		state.update(agg)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.symbols[symbol] = state
+	return nil
+}
+
+// Add updates the symbol's indicator with the next aggregate and returns the indicator's new value, or false if
+// the indicator doesn't have a value yet or the aggregate was ignored because it isn't newer than the last one.
+func (s *Stream[V]) Add(symbol string, agg models.Agg) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.symbols[symbol]
+	if !ok {
+		state = &symbolState[V]{indicator: s.newIndicator()}
+		s.symbols[symbol] = state
+	}
+	return state.update(agg)
+}
+
+// Latest returns the latest value of the symbol's indicator, or false if it doesn't have one.
+func (s *Stream[V]) Latest(symbol string) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state, ok := s.symbols[symbol]; ok && state.ok {
+		return state.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Run adds the equity aggregates (wsmodels.EquityAgg) of an event type ("AM" for minute aggregates or "A" for
+// second aggregates) that are received on in, e.g. the output of a WebSocket client, and returns a channel of the
+// updated values. Other messages are ignored, so to compute several indicators from the same client, read its
+// output and call Add on each stream instead. The returned channel is closed once in is closed or the context is
+// done.
+func (s *Stream[V]) Run(ctx context.Context, in <-chan any, eventType string) <-chan SymbolValue[V] {
+	out := make(chan SymbolValue[V])
+	go func() {
+		defer close(out)
+		for {
+			var msg any
+			select {
+			case <-ctx.Done():
+				return
+			case m, ok := <-in:
+				if !ok {
+					return
+				}
+				msg = m
+			}
+
+			agg, ok := msg.(wsmodels.EquityAgg)
+			if !ok || agg.EventType.EventType != eventType {
+				continue
+			}
+			v, ok := s.Add(agg.Symbol, FromEquityAgg(agg))
+			if !ok {
+				continue
+			}
+			select {
+			case out <- SymbolValue[V]{Symbol: agg.Symbol, Value: v}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (st *symbolState[V]) update(agg models.Agg) (V, bool) {
+	ts := time.Time(agg.Timestamp)
+	if !st.last.IsZero() && !ts.After(st.last) {
+		var zero V
+		return zero, false
+	}
+	st.last = ts
+
+	v, ok := st.indicator.Update(agg)
+	if ok {
+		st.value, st.ok = v, true
+	}
+	return v, ok
+}
+
+// FromEquityAgg converts an aggregate of the WebSocket API to an aggregate of the REST API, whose timestamp is the
+// start of the aggregate window.
+func FromEquityAgg(agg wsmodels.EquityAgg) models.Agg {
+	return models.Agg{
+		Ticker:    agg.Symbol,
+		Open:      agg.Open,
+		High:      agg.High,
+		Low:       agg.Low,
+		Close:     agg.Close,
+		Volume:    agg.Volume,
+		VWAP:      agg.VWAP,
+		Timestamp: models.Millis(time.UnixMilli(agg.StartTimestamp)),
+		OTC:       agg.OTC,
+	}
+}
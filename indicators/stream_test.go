@@ -0,0 +1,151 @@
+package indicators_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/indicators"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/models"
+	wsmodels "github.com/massive-com/client-go/v2/websocket/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEMA() indicators.Indicator[models.SingleIndicatorValue] {
+	return indicators.NewEMA(10, models.Close)
+}
+
+func equityAgg(symbol string, agg models.Agg) wsmodels.EquityAgg {
+	start := time.Time(agg.Timestamp).UnixMilli()
+	return wsmodels.EquityAgg{
+		EventType:      wsmodels.EventType{EventType: "AM"},
+		Symbol:         symbol,
+		Open:           agg.Open,
+		High:           agg.High,
+		Low:            agg.Low,
+		Close:          agg.Close,
+		Volume:         agg.Volume,
+		StartTimestamp: start,
+		EndTimestamp:   start + 60000,
+	}
+}
+
+func TestStream(t *testing.T) {
+	aggs := aggs(t)
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, "https://api.massive.com/v2/aggs/ticker/AAPL/range/1/day/1577941200000/1580360400000",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, models.ListAggsResponse{Results: aggs[:29]}))
+
+	s := indicators.NewStream(newEMA)
+	err := s.Seed("AAPL", c.ListAggs(context.Background(), &models.ListAggsParams{
+		Ticker:     "AAPL",
+		Multiplier: 1,
+		Timespan:   models.Day,
+		From:       aggs[0].Timestamp,
+		To:         aggs[28].Timestamp,
+	}).All())
+	require.Nil(t, err)
+
+	latest, ok := s.Latest("AAPL")
+	assert.True(t, ok)
+	assert.Equal(t, aggs[28].Timestamp, latest.Timestamp)
+
+	in := make(chan any, 5)
+	in <- equityAgg("AAPL", aggs[28]) // the last aggregate of the history
+	in <- wsmodels.EquityTrade{Symbol: "AAPL", Price: 44.5}
+	in <- equityAgg("MSFT", aggs[0])
+	in <- equityAgg("AAPL", aggs[29])
+	in <- equityAgg("AAPL", aggs[29]) // delivered again
+	close(in)
+
+	var values []indicators.SymbolValue[models.SingleIndicatorValue]
+	for v := range s.Run(context.Background(), in, "AM") {
+		values = append(values, v)
+	}
+	require.Len(t, values, 1)
+	assert.Equal(t, "AAPL", values[0].Symbol)
+	assert.Equal(t, aggs[29].Timestamp, values[0].Value.Timestamp)
+	assert.InDelta(t, 45.06135420391587, values[0].Value.Value, delta)
+
+	// MSFT wasn't seeded, so its indicator is warming up
+	_, ok = s.Latest("MSFT")
+	assert.False(t, ok)
+}
+
+func TestStreamSeedError(t *testing.T) {
+	aggs := aggs(t)
+	s := indicators.NewStream(newEMA)
+	for _, agg := range aggs[:12] {
+		s.Add("AAPL", agg)
+	}
+
+	errFailed := errors.New("failed")
+	err := s.Seed("AAPL", func(yield func(models.Agg, error) bool) {
+		yield(models.Agg{}, errFailed)
+	})
+	assert.ErrorIs(t, err, errFailed)
+
+	// the failed seed left the indicator as it was
+	v, ok := s.Add("AAPL", aggs[12])
+	assert.True(t, ok)
+	assert.Equal(t, aggs[12].Timestamp, v.Timestamp)
+}
+
+func TestStreamRunCanceled(t *testing.T) {
+	aggs := aggs(t)
+	s := indicators.NewStream(func() indicators.Indicator[models.SingleIndicatorValue] { return indicators.NewOBV() })
+
+	in := make(chan any, 1)
+	in <- equityAgg("AAPL", aggs[0])
+	ctx, cancel := context.WithCancel(context.Background())
+	out := s.Run(ctx, in, "AM")
+
+	v := <-out
+	assert.Equal(t, "AAPL", v.Symbol)
+	cancel()
+
+	_, open := <-out
+	assert.False(t, open)
+}
+
+func TestStreamRunEventType(t *testing.T) {
+	aggs := aggs(t)
+	s := indicators.NewStream(func() indicators.Indicator[models.SingleIndicatorValue] { return indicators.NewOBV() })
+
+	second := func(agg models.Agg) wsmodels.EquityAgg {
+		a := equityAgg("AAPL", agg)
+		a.EventType.EventType = "A"
+		a.EndTimestamp = a.StartTimestamp + 1000
+		return a
+	}
+
+	in := make(chan any, 4)
+	in <- equityAgg("AAPL", aggs[0])
+	in <- second(aggs[1]) // a second aggregate within the minute of the next one
+	in <- equityAgg("AAPL", aggs[1])
+	in <- second(aggs[2])
+	close(in)
+
+	var timestamps []models.Millis
+	for v := range s.Run(context.Background(), in, "AM") {
+		timestamps = append(timestamps, v.Value.Timestamp)
+	}
+	assert.Equal(t, []models.Millis{aggs[0].Timestamp, aggs[1].Timestamp}, timestamps)
+
+	// the OBV only moved with the minute aggregates
+	latest, ok := s.Latest("AAPL")
+	assert.True(t, ok)
+	expected := indicators.NewOBV()
+	expected.Update(aggs[0])
+	want, _ := expected.Update(aggs[1])
+	assert.Equal(t, want, latest)
+}
@@ -0,0 +1,89 @@
+package indicators
+
+import (
+	"math"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// BollingerBandsValue is a value of Bollinger Bands.
+type BollingerBandsValue struct {
+	Timestamp models.Millis `json:"timestamp,omitempty"`
+
+	// Middle is the simple moving average of the price.
+	Middle float64 `json:"middle,omitempty"`
+
+	// Upper is the middle band plus a multiple of the standard deviation of the price.
+	Upper float64 `json:"upper,omitempty"`
+
+	// Lower is the middle band minus a multiple of the standard deviation of the price.
+	Lower float64 `json:"lower,omitempty"`
+}
+
+// BollingerBands calculates Bollinger Bands of a series.
+type BollingerBands struct {
+	series models.SeriesType
+	values *window
+	k      float64
+}
+
+// NewBollingerBands returns a calculator of Bollinger Bands of the given price (e.g. models.Close), i.e. its simple
+// moving average over a window of aggregates and bands k (population) standard deviations above and below it
+// (commonly 20 and 2). Windows less than 1 are treated as 1.
+func NewBollingerBands(window int, k float64, series models.SeriesType) *BollingerBands {
+	return &BollingerBands{series: series, values: newWindow(window), k: k}
+}
+
+// Update implements Indicator. The first value is at the window's last aggregate.
+func (b *BollingerBands) Update(agg models.Agg) (BollingerBandsValue, bool) {
+	b.values.push(price(agg, b.series))
+	if !b.values.full {
+		return BollingerBandsValue{}, false
+	}
+
+	n := float64(len(b.values.values))
+	var sum float64
+	for _, v := range b.values.values {
+		sum += v
+	}
+	mean := sum / n
+
+	var squares float64
+	for _, v := range b.values.values {
+		squares += (v - mean) * (v - mean)
+	}
+	width := b.k * math.Sqrt(squares/n)
+
+	return BollingerBandsValue{Timestamp: agg.Timestamp, Middle: mean, Upper: mean + width, Lower: mean - width}, true
+}
+
+// ATR calculates the average true range of a series.
+type ATR struct {
+	prevClose float64
+	started   bool
+	ranges    wilder
+}
+
+// NewATR returns a calculator of the average true range over a window of aggregates (commonly 14), with Wilder's
+// smoothing. The true range of an aggregate is its high-low range extended to the previous close price. Windows
+// less than 1 are treated as 1.
+func NewATR(window int) *ATR {
+	return &ATR{ranges: wilder{window: max(window, 1)}}
+}
+
+// Update implements Indicator. The first value is at the aggregate after the window's last one, since true ranges
+// need the previous close price.
+func (a *ATR) Update(agg models.Agg) (models.SingleIndicatorValue, bool) {
+	if !a.started {
+		a.prevClose, a.started = agg.Close, true
+		return models.SingleIndicatorValue{}, false
+	}
+
+	tr := max(agg.High-agg.Low, math.Abs(agg.High-a.prevClose), math.Abs(agg.Low-a.prevClose))
+	a.prevClose = agg.Close
+	value, ok := a.ranges.update(tr)
+	if !ok {
+		return models.SingleIndicatorValue{}, false
+	}
+	return models.SingleIndicatorValue{Timestamp: agg.Timestamp, Value: value}, true
+}
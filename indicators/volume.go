@@ -0,0 +1,63 @@
+package indicators
+
+import (
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// VWAP calculates the cumulative volume weighted average price of a series.
+type VWAP struct {
+	value, volume float64
+}
+
+// NewVWAP returns a calculator of the volume weighted average price of all aggregates since it was created or
+// reset. The price of an aggregate is its own VWAP if it has one, and its typical price ((high+low+close)/3)
+// otherwise. It's usually reset at the start of every trading session.
+func NewVWAP() *VWAP {
+	return &VWAP{}
+}
+
+// Update implements Indicator. There's no value until an aggregate with volume was added.
+func (w *VWAP) Update(agg models.Agg) (models.SingleIndicatorValue, bool) {
+	p := agg.VWAP
+	if p == 0 {
+		p = (agg.High + agg.Low + agg.Close) / 3
+	}
+	w.value += p * agg.Volume
+	w.volume += agg.Volume
+	if w.volume == 0 {
+		return models.SingleIndicatorValue{}, false
+	}
+	return models.SingleIndicatorValue{Timestamp: agg.Timestamp, Value: w.value / w.volume}, true
+}
+
+// Reset starts a new average, e.g. at the start of a trading session.
+func (w *VWAP) Reset() {
+	w.value, w.volume = 0, 0
+}
+
+// OBV calculates the on-balance volume of a series.
+type OBV struct {
+	prevClose float64
+	started   bool
+	value     float64
+}
+
+// NewOBV returns a calculator of the on-balance volume, i.e. the running total of the volume of aggregates that
+// closed higher than the previous one minus the volume of those that closed lower. It starts at 0.
+func NewOBV() *OBV {
+	return &OBV{}
+}
+
+// Update implements Indicator. There's a value at every aggregate.
+func (o *OBV) Update(agg models.Agg) (models.SingleIndicatorValue, bool) {
+	if o.started {
+		switch {
+		case agg.Close > o.prevClose:
+			o.value += agg.Volume
+		case agg.Close < o.prevClose:
+			o.value -= agg.Volume
+		}
+	}
+	o.prevClose, o.started = agg.Close, true
+	return models.SingleIndicatorValue{Timestamp: agg.Timestamp, Value: o.value}, true
+}
@@ -0,0 +1,88 @@
+// Command restgen generates endpoints of the REST client from the OpenAPI spec and writes a report of the spec
+// paths that the client doesn't cover. It's run by go generate in the rest package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/massive-com/client-go/v2/internal/restgen"
+)
+
+func main() {
+	specPath := flag.String("spec", "../.massive/rest.json", "path of the OpenAPI spec")
+	configPath := flag.String("config", "../.massive/endpoints.json", "path of the endpoints config")
+	dir := flag.String("dir", ".", "directory of the rest package")
+	driftPath := flag.String("drift", "../.massive/DRIFT.md", "path of the drift report")
+	flag.Parse()
+
+	spec, err := restgen.LoadSpec(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cfg, err := restgen.LoadConfig(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	existing, err := restgen.ExistingTypes(filepath.Join(*dir, "models"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := restgen.Generate(spec, cfg, existing)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := removeStale(*dir, files); err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(*dir, f.Path), f.Content, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	paths, err := restgen.ClientPaths(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var report bytes.Buffer
+	if err := restgen.WriteReport(&report, restgen.Drift(spec, paths)); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*driftPath, report.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// removeStale removes generated files of endpoints that were removed from the config.
+func removeStale(dir string, files []restgen.File) error {
+	keep := make(map[string]bool)
+	for _, f := range files {
+		keep[filepath.Join(dir, f.Path)] = true
+	}
+
+	for _, pattern := range []string{"*_gen.go", "*_gen_test.go", "models/*_gen.go"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return err
+		}
+		for _, path := range matches {
+			if keep[path] {
+				continue
+			}
+			if generated, err := restgen.IsGenerated(path); err != nil {
+				return err
+			} else if generated {
+				if err := os.Remove(path); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Command wsgen generates the topics and event models of the WebSocket client from the WebSocket spec. It's run by
+// go generate in the websocket package.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/massive-com/client-go/v2/internal/wsgen"
+)
+
+func main() {
+	specPath := flag.String("spec", "../.massive/websocket.json", "path of the WebSocket spec")
+	configPath := flag.String("config", "../.massive/topics.json", "path of the topics config")
+	dir := flag.String("dir", ".", "directory of the websocket package")
+	flag.Parse()
+
+	spec, err := wsgen.LoadSpec(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cfg, err := wsgen.LoadConfig(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	files, err := wsgen.Generate(spec, cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(*dir, f.Path), f.Content, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package restgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Config lists the endpoints that are generated.
+type Config struct {
+	Endpoints []Endpoint `json:"endpoints"`
+}
+
+// Endpoint configures the code that's generated for a path of the spec.
+type Endpoint struct {
+	// Path is the path of the spec, e.g. "/vX/reference/tickers/taxonomies".
+	Path string `json:"path"`
+
+	// Method is the name of the client method, e.g. "ListTickerTaxonomies". The params and response models are
+	// named after it (e.g. ListTickerTaxonomiesParams).
+	Method string `json:"method"`
+
+	// Client is the client type that the method is defined on, e.g. "ReferenceClient" or "VXClient".
+	Client string `json:"client"`
+
+	// File is the base name of the generated files. Endpoints with the same file are generated together.
+	File string `json:"file"`
+
+	// Result is the name of the model of the response's results, e.g. "TickerTaxonomy".
+	Result string `json:"result,omitempty"`
+
+	// Doc completes the method's doc comment after its name, e.g. "retrieves taxonomy classifications of tickers."
+	Doc string `json:"doc"`
+
+	// DocsURL is a link to the API docs of the endpoint.
+	DocsURL string `json:"docs_url,omitempty"`
+
+	// Types overrides the Go types of params and model fields by their name in the spec, e.g. {"timestamp":
+	// "Nanos"}. Types of the models package must not be qualified.
+	Types map[string]string `json:"types,omitempty"`
+}
+
+// LoadConfig reads a config from a JSON file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode config %s: %w", path, err)
+	}
+	for _, e := range cfg.Endpoints {
+		if e.Path == "" || e.Method == "" || e.Client == "" || e.File == "" {
+			return nil, fmt.Errorf("endpoint %q: path, method, client and file are required", e.Path)
+		}
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,173 @@
+package restgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Uncovered is an operation of the spec that the client doesn't cover.
+type Uncovered struct {
+	Path        string
+	OperationID string
+	Summary     string
+	Draft       bool
+}
+
+// Drift returns the spec paths that none of the client's path templates cover, sorted by path. Paths that the spec
+// marks as ignored are skipped.
+func Drift(spec *Spec, templates []string) []Uncovered {
+	var drift []Uncovered
+	for path, item := range spec.Paths {
+		if item.Ignore || item.Get == nil {
+			continue
+		}
+
+		covered := false
+		for _, t := range templates {
+			if covers(t, path) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			drift = append(drift, Uncovered{Path: path, OperationID: item.Get.OperationID, Summary: item.Get.Summary, Draft: item.Draft})
+		}
+	}
+
+	sort.Slice(drift, func(i, j int) bool { return drift[i].Path < drift[j].Path })
+	return drift
+}
+
+// covers reports whether a path template of the client matches a path of the spec. Params of the template match any
+// segment, e.g. "/v2/aggs/ticker/{ticker}/prev" covers "/v2/aggs/ticker/{stocksTicker}/prev", while params of the
+// spec only match params.
+func covers(template, path string) bool {
+	ts, ps := strings.Split(template, "/"), strings.Split(path, "/")
+	if len(ts) != len(ps) {
+		return false
+	}
+	for i := range ts {
+		if isParam(ts[i]) {
+			continue
+		}
+		if ts[i] != ps[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// WriteReport writes a Markdown report of the spec paths that the client doesn't cover.
+func WriteReport(w io.Writer, drift []Uncovered) error {
+	var b strings.Builder
+	b.WriteString("# REST API drift\n\n")
+	b.WriteString("<!-- Code generated by restgen from .massive/rest.json; DO NOT EDIT. -->\n\n")
+	if len(drift) == 0 {
+		b.WriteString("Every path of the spec is covered by the client.\n")
+	} else {
+		b.WriteString("These paths of `.massive/rest.json` aren't covered by the client. Add them to `.massive/endpoints.json` to generate them, or implement them by hand, and run `make generate`.\n\n")
+		b.WriteString("| Path | Operation | Summary |\n| --- | --- | --- |\n")
+		for _, d := range drift {
+			summary := d.Summary
+			if d.Draft {
+				summary += " (draft)"
+			}
+			fmt.Fprintf(&b, "| `%s` | %s | %s |\n", d.Path, d.OperationID, summary)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ClientPaths returns the path templates of the client, which are the values of the string constants of a package
+// whose names end in "Path".
+func ClientPaths(dir string) ([]string, error) {
+	var paths []string
+	err := parseDir(dir, func(file *ast.File, _ bool) {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if !strings.HasSuffix(name.Name, "Path") || i >= len(vs.Values) {
+						continue
+					}
+					if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						if path, err := strconv.Unquote(lit.Value); err == nil {
+							paths = append(paths, path)
+						}
+					}
+				}
+			}
+		}
+	})
+	return paths, err
+}
+
+// ExistingTypes returns the names of the types that the Go files of a package define outside of generated files.
+func ExistingTypes(dir string) (map[string]bool, error) {
+	types := make(map[string]bool)
+	err := parseDir(dir, func(file *ast.File, generated bool) {
+		if generated {
+			return
+		}
+		for _, decl := range file.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+				for _, spec := range gen.Specs {
+					types[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	})
+	return types, err
+}
+
+// IsGenerated reports whether a file was generated by restgen.
+func IsGenerated(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	return strings.HasPrefix(string(data), Header), nil
+}
+
+// parseDir parses the non-test Go files of a directory.
+func parseDir(dir string, f func(file *ast.File, generated bool)) error {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	for _, path := range matches {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		generated, err := IsGenerated(path)
+		if err != nil {
+			return err
+		}
+		f(file, generated)
+	}
+	return nil
+}
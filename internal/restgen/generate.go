@@ -0,0 +1,567 @@
+package restgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Header starts every generated file. It's also used to find stale generated files.
+const Header = "// Code generated by restgen from .massive/rest.json; DO NOT EDIT."
+
+// baseFields are the properties of responses that models.BaseResponse defines.
+var baseFields = map[string]bool{
+	"count": true, "error": true, "message": true, "next_url": true, "request_id": true, "status": true,
+}
+
+// File is a generated file.
+type File struct {
+	// Path is the path of the file relative to the rest package, e.g. "models/sec_gen.go".
+	Path    string
+	Content []byte
+}
+
+// Generate returns the files for the endpoints of the config: models, client methods and tests, one of each per
+// endpoint file. Existing holds the names of the types that the models package defines outside of generated
+// files, which generated models must not redefine.
+func Generate(spec *Spec, cfg *Config, existing map[string]bool) ([]File, error) {
+	var files []File
+	var order []string
+	groups := make(map[string][]Endpoint)
+	for _, e := range cfg.Endpoints {
+		if _, ok := groups[e.File]; !ok {
+			order = append(order, e.File)
+		}
+		groups[e.File] = append(groups[e.File], e)
+	}
+
+	defined := make(map[string]bool)
+	for name := range existing {
+		defined[name] = true
+	}
+
+	for _, name := range order {
+		g := &generator{defined: defined}
+		for _, e := range groups[name] {
+			if err := g.endpoint(spec, e); err != nil {
+				return nil, fmt.Errorf("endpoint %s: %w", e.Path, err)
+			}
+		}
+
+		out, err := g.files(name)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, out...)
+	}
+	return files, nil
+}
+
+// generator accumulates the code of the endpoints of a file.
+type generator struct {
+	defined map[string]bool
+
+	models  bytes.Buffer
+	paths   []string
+	methods bytes.Buffer
+	tests   bytes.Buffer
+
+	list        bool
+	testImports map[string]bool
+}
+
+// endpointInfo is the generated code that the client method and test of an endpoint depend on.
+type endpointInfo struct {
+	Endpoint
+	op         *Operation
+	draft      bool
+	params     []Parameter
+	hasParams  bool
+	list       bool
+	resultType string
+}
+
+func (g *generator) endpoint(spec *Spec, e Endpoint) error {
+	item, ok := spec.Paths[e.Path]
+	if !ok || item.Get == nil {
+		return fmt.Errorf("path isn't in the spec")
+	}
+
+	info := endpointInfo{Endpoint: e, op: item.Get, draft: item.Draft, params: item.Get.Parameters}
+	info.hasParams = len(info.params) > 0
+	if info.hasParams {
+		if err := g.params(e, info.params); err != nil {
+			return err
+		}
+	}
+	if err := g.response(&info); err != nil {
+		return err
+	}
+
+	g.paths = append(g.paths, fmt.Sprintf("%sPath = %q", e.Method, e.Path))
+	g.method(info)
+	return g.test(info)
+}
+
+// define reserves a type name in the models package.
+func (g *generator) define(name string) error {
+	if g.defined[name] {
+		return fmt.Errorf("type %s is already defined in the models package", name)
+	}
+	g.defined[name] = true
+	return nil
+}
+
+// params writes the params struct and its builders.
+func (g *generator) params(e Endpoint, params []Parameter) error {
+	name := e.Method + "Params"
+	if err := g.define(name); err != nil {
+		return err
+	}
+
+	names := make(map[string]bool)
+	ranges := make(map[string]bool)
+	for _, p := range params {
+		names[p.Name] = true
+		if p.Filter != nil && p.Filter.Range {
+			ranges[p.Name] = true
+		}
+	}
+
+	var fields, builders bytes.Buffer
+	for _, p := range params {
+		if base, ok := rangeParam(p.Name); ok && ranges[base] {
+			continue // part of the range of its base param
+		}
+
+		field := goName(p.Name)
+		if p.GoID != "" {
+			field = p.GoID
+		}
+		typ := g.paramType(e, p)
+		fields.WriteString("\n" + Comment(p.Description, "\t"))
+
+		if p.In == "path" {
+			fmt.Fprintf(&fields, "\t%s %s `validate:\"required\" path:\"%s\"`\n", field, typ, p.Name)
+			continue
+		}
+
+		if ranges[p.Name] {
+			fmt.Fprintf(&fields, "\t%sEQ *%s `query:\"%s\"`\n", field, typ, p.Name)
+			fmt.Fprintf(&builders, "\nfunc (p %s) With%s(c Comparator, q %s) *%s {\n\tswitch c {\n\tcase EQ:\n\t\tp.%sEQ = &q\n", name, field, typ, name, field)
+			for _, op := range []string{"lt", "lte", "gt", "gte"} {
+				if !names[p.Name+"."+op] {
+					continue
+				}
+				suffix := strings.ToUpper(op)
+				fmt.Fprintf(&fields, "\t%s%s *%s `query:\"%s.%s\"`\n", field, suffix, typ, p.Name, op)
+				fmt.Fprintf(&builders, "\tcase %s:\n\t\tp.%s%s = &q\n", suffix, field, suffix)
+			}
+			builders.WriteString("\t}\n\treturn &p\n}\n")
+			continue
+		}
+
+		fmt.Fprintf(&fields, "\t%s *%s `query:\"%s\"`\n", field, typ, p.Name)
+		fmt.Fprintf(&builders, "\nfunc (p %s) With%s(q %s) *%s {\n\tp.%s = &q\n\treturn &p\n}\n", name, field, typ, name, field)
+	}
+
+	fmt.Fprintf(&g.models, "\n// %s is the set of parameters for the %s method.\ntype %s struct {%s}\n", name, e.Method, name, fields.String())
+	g.models.Write(builders.Bytes())
+	return nil
+}
+
+// rangeParam returns the base name of a range param like "ticker.gte".
+func rangeParam(name string) (string, bool) {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "", false
+	}
+	switch name[i+1:] {
+	case "lt", "lte", "gt", "gte":
+		return name[:i], true
+	}
+	return "", false
+}
+
+// paramType returns the Go type of a param.
+func (g *generator) paramType(e Endpoint, p Parameter) string {
+	if t, ok := e.Types[p.Name]; ok {
+		return t
+	}
+	switch p.Name {
+	case "order":
+		return "Order"
+	case "sort":
+		return "Sort"
+	case "limit":
+		return "int"
+	}
+
+	if p.Schema == nil {
+		return "string"
+	}
+	switch p.Schema.Type {
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "string":
+		if p.Schema.Format == "date" {
+			return "Date"
+		}
+	}
+	return "string"
+}
+
+// response writes the response model and the models of its results.
+func (g *generator) response(info *endpointInfo) error {
+	schema, _ := info.op.JSON()
+	if schema == nil {
+		return fmt.Errorf("operation has no JSON response")
+	}
+
+	name := info.Method + "Response"
+	if err := g.define(name); err != nil {
+		return err
+	}
+
+	results, ok := schema.Properties["results"]
+	if !ok {
+		fields, err := g.fields(info.Endpoint, name, schema, nil)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&g.models, "\n// %s is the response returned by the %s method.\ntype %s struct {%s}\n", name, info.Method, name, fields)
+		return nil
+	}
+
+	if info.Result == "" {
+		return fmt.Errorf("result is required for responses with results")
+	}
+	info.list = results.Type == "array" && schema.Properties["next_url"] != nil
+	typ, err := g.goType(info.Endpoint, "results", results, info.Result)
+	if err != nil {
+		return err
+	}
+	if info.list {
+		info.resultType = strings.TrimPrefix(typ, "[]")
+		g.list = true
+	}
+
+	extra, err := g.fields(info.Endpoint, name, schema, func(prop string) bool { return baseFields[prop] || prop == "results" })
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(&g.models, "\n// %s is the response returned by the %s method.\ntype %s struct {\n\tBaseResponse\n%s\tResults %s `json:\"results,omitempty\"`\n}\n",
+		name, info.Method, name, extra, typ)
+	return nil
+}
+
+// fields returns the struct fields of an object schema's properties, skipping the properties that skip returns true
+// for, and writes the models of nested objects.
+func (g *generator) fields(e Endpoint, parent string, s *Schema, skip func(string) bool) (string, error) {
+	var b strings.Builder
+	for _, prop := range s.propertyNames() {
+		if skip != nil && skip(prop) {
+			continue
+		}
+		ps := s.Properties[prop]
+		field := goName(prop)
+		typ, err := g.goType(e, prop, ps, parent+field)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "\n%s\t%s %s `json:\"%s,omitempty\"`\n", Comment(ps.Description, "\t"), field, typ, prop)
+	}
+	return b.String(), nil
+}
+
+// goType returns the Go type of a model property and writes the model of nested objects, named typeName. The
+// objects of arrays are named typeName too.
+func (g *generator) goType(e Endpoint, prop string, s *Schema, typeName string) (string, error) {
+	if t, ok := e.Types[prop]; ok {
+		return t, nil
+	}
+	if s == nil {
+		return "any", nil
+	}
+
+	switch s.Type {
+	case "string":
+		return "string", nil
+	case "integer":
+		if s.Format == "int64" {
+			return "int64", nil
+		}
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		item, err := g.goType(e, prop, s.Items, typeName)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + item, nil
+	case "object":
+		if len(s.Properties) == 0 {
+			return "map[string]any", nil
+		}
+		if err := g.define(typeName); err != nil {
+			return "", err
+		}
+		fields, err := g.fields(e, typeName, s, nil)
+		if err != nil {
+			return "", err
+		}
+		doc := Comment(fmt.Sprintf("%s is a model of the %s method's response. %s", typeName, e.Method, s.Description), "")
+		fmt.Fprintf(&g.models, "\n%stype %s struct {%s}\n", doc, typeName, fields)
+		return typeName, nil
+	}
+	return "any", nil
+}
+
+// method writes the client method of an endpoint.
+func (g *generator) method(info endpointInfo) {
+	var doc strings.Builder
+	fmt.Fprintf(&doc, "// %s %s\n", info.Method, info.Doc)
+	if info.DocsURL != "" {
+		fmt.Fprintf(&doc, "// For more details see %s.\n", info.DocsURL)
+	}
+	if info.draft || info.op.Experimental != nil {
+		doc.WriteString("//\n// Note: this method utilizes an experimental API and could experience breaking changes or deprecation.\n")
+	}
+
+	params, arg := "", "nil"
+	if info.hasParams {
+		params, arg = fmt.Sprintf(" params *models.%sParams,", info.Method), "params"
+	}
+
+	if info.list {
+		doc.WriteString("//\n// This method returns an iterator that should be used to access the results via this pattern:\n//\n")
+		fmt.Fprintf(&doc, "//\titer := c.%s(context.TODO(), params, opts...)\n", info.Method)
+		doc.WriteString("//\tfor iter.Next() {\n//\t\tlog.Print(iter.Item()) // do something with the current value\n//\t}\n")
+		doc.WriteString("//\tif iter.Err() != nil {\n//\t\treturn iter.Err()\n//\t}\n")
+
+		item := qualify(info.resultType)
+		fmt.Fprintf(&g.methods, "\n%sfunc (c *%s) %s(ctx context.Context,%s options ...models.RequestOption) *iter.Iter[%s] {\n", doc.String(), info.Client, info.Method, params, item)
+		fmt.Fprintf(&g.methods, "\treturn iter.NewIter(ctx, %sPath, %s, func(ctx context.Context, uri string) (iter.ListResponse, []%s, error) {\n", info.Method, arg, item)
+		fmt.Fprintf(&g.methods, "\t\tres := &models.%sResponse{}\n", info.Method)
+		fmt.Fprintf(&g.methods, "\t\terr := c.CallPage(ctx, http.MethodGet, %sPath, uri, res, options...)\n", info.Method)
+		g.methods.WriteString("\t\treturn res, res.Results, err\n\t}, options...)\n}\n")
+		return
+	}
+
+	fmt.Fprintf(&g.methods, "\n%sfunc (c *%s) %s(ctx context.Context,%s options ...models.RequestOption) (*models.%sResponse, error) {\n", doc.String(), info.Client, info.Method, params, info.Method)
+	fmt.Fprintf(&g.methods, "\tres := &models.%sResponse{}\n", info.Method)
+	fmt.Fprintf(&g.methods, "\terr := c.Call(ctx, http.MethodGet, %sPath, %s, res, options...)\n", info.Method, arg)
+	g.methods.WriteString("\treturn res, err\n}\n")
+}
+
+// qualify returns a Go type of the models package as it's referred to from the rest package.
+func qualify(typ string) string {
+	switch typ {
+	case "any", "bool", "float64", "int", "int64", "string", "map[string]any":
+		return typ
+	}
+	return "models." + typ
+}
+
+// test writes an httpmock test of an endpoint that decodes the example response of the spec, or a sample response
+// built from its schema if there's no example.
+func (g *generator) test(info endpointInfo) error {
+	schema, example := info.op.JSON()
+
+	var fields map[string]json.RawMessage
+	if example != nil {
+		if err := json.Unmarshal(example, &fields); err != nil {
+			return fmt.Errorf("invalid example: %w", err)
+		}
+	}
+	if len(fields) == 0 {
+		data, err := json.Marshal(sample(schema))
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+	}
+	// a next page would have to be mocked too
+	delete(fields, "next_url")
+	data, err := json.MarshalIndent(fields, "\t", "\t")
+	if err != nil {
+		return err
+	}
+	if bytes.ContainsRune(data, '`') {
+		return nil
+	}
+
+	path := info.Path
+	var values strings.Builder
+	for _, p := range info.params {
+		if p.In != "path" {
+			continue
+		}
+		value, literal := g.exampleValue(info.Endpoint, p)
+		path = strings.ReplaceAll(path, "{"+p.Name+"}", url.PathEscape(value))
+		field := goName(p.Name)
+		if p.GoID != "" {
+			field = p.GoID
+		}
+		fmt.Fprintf(&values, "\n\t\t%s: %s,", field, literal)
+	}
+
+	params := ""
+	if info.hasParams {
+		params = fmt.Sprintf(", &models.%sParams{%s}", info.Method, values.String())
+		if values.Len() > 0 {
+			params = fmt.Sprintf(", &models.%sParams{%s\n\t}", info.Method, values.String())
+		}
+	}
+	client := "c"
+	if info.Client == "VXClient" {
+		client = "c.VX"
+	}
+
+	fmt.Fprintf(&g.tests, "\nfunc Test%s(t *testing.T) {\n\tc := massive.New(\"API_KEY\")\n\n", info.Method)
+	g.tests.WriteString("\thttpmock.ActivateNonDefault(c.HTTP.GetClient())\n\tdefer httpmock.DeactivateAndReset()\n\n")
+	fmt.Fprintf(&g.tests, "\texpectedResponse := `%s`\n\n", data)
+	fmt.Fprintf(&g.tests, "\tregisterResponder(%q, expectedResponse)\n\n", "https://api.massive.com"+path)
+	fmt.Fprintf(&g.tests, "\tvar expect models.%sResponse\n\terr := json.Unmarshal([]byte(expectedResponse), &expect)\n\tassert.Nil(t, err)\n\n", info.Method)
+
+	if info.list {
+		fmt.Fprintf(&g.tests, "\titer := %s.%s(context.Background()%s)\n", client, info.Method, params)
+		fmt.Fprintf(&g.tests, "\tvar results []%s\n\tfor iter.Next() {\n\t\tresults = append(results, iter.Item())\n\t}\n", qualify(info.resultType))
+		g.tests.WriteString("\tassert.Nil(t, iter.Err())\n\tassert.ElementsMatch(t, expect.Results, results)\n}\n")
+	} else {
+		fmt.Fprintf(&g.tests, "\tres, err := %s.%s(context.Background()%s)\n", client, info.Method, params)
+		g.tests.WriteString("\tassert.Nil(t, err)\n\tassert.Equal(t, &expect, res)\n}\n")
+	}
+	return nil
+}
+
+// sample returns a sample value of a schema, using the examples of its properties where there are any.
+func sample(s *Schema) any {
+	if s == nil {
+		return nil
+	}
+	if s.Example != nil && s.Type != "object" && s.Type != "array" {
+		return s.Example
+	}
+
+	switch s.Type {
+	case "object":
+		obj := make(map[string]any)
+		for _, prop := range s.propertyNames() {
+			obj[prop] = sample(s.Properties[prop])
+		}
+		return obj
+	case "array":
+		return []any{sample(s.Items)}
+	case "integer":
+		return 1
+	case "number":
+		return 1.5
+	case "boolean":
+		return true
+	case "string":
+		switch s.Format {
+		case "date":
+			return "2021-07-22"
+		case "date-time":
+			return "2021-07-22T00:00:00Z"
+		}
+		return "x"
+	}
+	return nil
+}
+
+// exampleValue returns an example value of a path param as it appears in the URL and as a Go literal.
+func (g *generator) exampleValue(e Endpoint, p Parameter) (string, string) {
+	raw := p.Example
+	if raw == nil && p.Schema != nil {
+		raw = p.Schema.Example
+	}
+
+	var value string
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		value = s
+	} else if raw != nil {
+		value = string(raw)
+	}
+
+	switch g.paramType(e, p) {
+	case "Date":
+		d, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			d = time.Date(2021, 7, 22, 0, 0, 0, 0, time.UTC)
+		}
+		if g.testImports == nil {
+			g.testImports = make(map[string]bool)
+		}
+		g.testImports["time"] = true
+		return d.Format("2006-01-02"), fmt.Sprintf("models.Date(time.Date(%d, %d, %d, 0, 0, 0, 0, time.UTC))", d.Year(), d.Month(), d.Day())
+	case "int":
+		if _, err := strconv.Atoi(value); err != nil {
+			value = "1"
+		}
+		return value, value
+	}
+
+	if value == "" {
+		value = "X"
+	}
+	return value, strconv.Quote(value)
+}
+
+// files returns the formatted files of a group of endpoints.
+func (g *generator) files(name string) ([]File, error) {
+	models := fmt.Sprintf("%s\n\npackage models\n%s", Header, g.models.String())
+
+	imports := "\t\"context\"\n\t\"net/http\"\n\n"
+	if g.list {
+		imports += "\t\"github.com/massive-com/client-go/v2/rest/iter\"\n"
+	}
+	imports += "\t\"github.com/massive-com/client-go/v2/rest/models\"\n"
+	client := fmt.Sprintf("%s\n\npackage massive\n\nimport (\n%s)\n\nconst (\n\t%s\n)\n%s", Header, imports, strings.Join(g.paths, "\n\t"), g.methods.String())
+
+	files := []File{
+		{Path: "models/" + name + "_gen.go", Content: []byte(models)},
+		{Path: name + "_gen.go", Content: []byte(client)},
+	}
+
+	if g.tests.Len() > 0 {
+		std := []string{"\"context\"", "\"encoding/json\"", "\"testing\""}
+		for imp := range g.testImports {
+			std = append(std, strconv.Quote(imp))
+		}
+		sort.Strings(std)
+		imports := "\t" + strings.Join(std, "\n\t") + "\n\n"
+		imports += "\t\"github.com/jarcoal/httpmock\"\n"
+		imports += "\tmassive \"github.com/massive-com/client-go/v2/rest\"\n"
+		imports += "\t\"github.com/massive-com/client-go/v2/rest/models\"\n"
+		imports += "\t\"github.com/stretchr/testify/assert\"\n"
+		tests := fmt.Sprintf("%s\n\npackage massive_test\n\nimport (\n%s)\n%s", Header, imports, g.tests.String())
+		files = append(files, File{Path: name + "_gen_test.go", Content: []byte(tests)})
+	}
+
+	for i, f := range files {
+		src, err := format.Source(f.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format %s: %w\n%s", f.Path, err, f.Content)
+		}
+		files[i].Content = src
+	}
+	return files, nil
+}
@@ -0,0 +1,80 @@
+package restgen
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// initialisms are words that are written in upper case in Go identifiers.
+var initialisms = map[string]bool{
+	"api": true, "cik": true, "cusip": true, "figi": true, "id": true, "ipo": true, "nbbo": true, "otc": true,
+	"sec": true, "sic": true, "trf": true, "uri": true, "url": true, "xbrl": true,
+}
+
+// goName returns the Go identifier of a name in the spec, e.g. "filing_id" -> "FilingID" and
+// "entities.company_data.name" -> "EntitiesCompanyDataName".
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range splitWords(name) {
+		if initialisms[strings.ToLower(word)] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+// splitWords splits a name at separators and camel case boundaries.
+func splitWords(name string) []string {
+	var words []string
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '.' || r == '-' || r == ' '
+	}) {
+		start := 0
+		runes := []rune(part)
+		for i := 1; i < len(runes); i++ {
+			if unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i-1]) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+var (
+	htmlTag     = regexp.MustCompile(`<[^>]*>`)
+	whitespace  = regexp.MustCompile(`\s+`)
+	punctuation = regexp.MustCompile(` ([.,;:])`)
+)
+
+// Comment returns the first paragraph of a description as a doc comment wrapped at 120 columns.
+func Comment(description, indent string) string {
+	text := strings.TrimSpace(description)
+	if i := strings.Index(text, "\n\n"); i >= 0 {
+		text = text[:i]
+	}
+	text = htmlTag.ReplaceAllString(text, " ")
+	text = strings.TrimSpace(whitespace.ReplaceAllString(text, " "))
+	text = punctuation.ReplaceAllString(text, "$1")
+	if text == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	line := indent + "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 120 && line != indent+"//" {
+			b.WriteString(line + "\n")
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	b.WriteString(line + "\n")
+	return b.String()
+}
@@ -0,0 +1,161 @@
+package restgen_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/massive-com/client-go/v2/internal/restgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const spec = `{
+	"paths": {
+		"/v1/widgets": {
+			"get": {
+				"operationId": "ListWidgets",
+				"summary": "Widgets",
+				"parameters": [
+					{"name": "ticker", "in": "query", "description": "Query by ticker.", "schema": {"type": "string"}, "x-polygon-filter-field": {"range": true}},
+					{"name": "ticker.gte", "in": "query", "schema": {"type": "string"}},
+					{"name": "ticker.lt", "in": "query", "schema": {"type": "string"}},
+					{"name": "has_xbrl", "in": "query", "schema": {"type": "boolean"}, "x-polygon-go-id": "HasXBRL"},
+					{"name": "limit", "in": "query", "schema": {"type": "integer"}}
+				],
+				"responses": {"200": {"content": {"application/json": {"schema": {
+					"properties": {
+						"next_url": {"type": "string"},
+						"request_id": {"type": "string"},
+						"status": {"type": "string"},
+						"results": {"type": "array", "items": {"type": "object", "properties": {
+							"ticker": {"type": "string", "description": "The ticker of the widget."},
+							"size_bytes": {"type": "integer", "format": "int64"},
+							"owner": {"type": "object", "properties": {"cik": {"type": "string"}}}
+						}}}
+					}
+				}}}}}
+			}
+		},
+		"/v1/widgets/{widget_id}/{date}": {
+			"get": {
+				"operationId": "GetWidget",
+				"parameters": [
+					{"name": "widget_id", "in": "path", "required": true, "schema": {"type": "string", "example": "abc"}},
+					{"name": "date", "in": "path", "required": true, "schema": {"type": "string", "format": "date"}}
+				],
+				"responses": {"200": {"content": {"application/json": {
+					"schema": {"properties": {"id": {"type": "string"}, "price": {"type": "number"}}},
+					"example": {"id": "abc", "price": 1.5}
+				}}}}
+			}
+		},
+		"/v1/widgets/{cryptoTicker}": {"get": {"operationId": "Ignored"}, "x-polygon-ignore": true},
+		"/v1/gadgets": {"get": {"operationId": "ListGadgets", "summary": "Gadgets"}, "x-polygon-draft": true}
+	}
+}`
+
+func loadSpec(t *testing.T) *restgen.Spec {
+	var s restgen.Spec
+	require.Nil(t, json.Unmarshal([]byte(spec), &s))
+	return &s
+}
+
+func files(t *testing.T, cfg *restgen.Config, existing map[string]bool) map[string]string {
+	out, err := restgen.Generate(loadSpec(t), cfg, existing)
+	require.Nil(t, err)
+
+	files := make(map[string]string)
+	for _, f := range out {
+		files[f.Path] = string(f.Content)
+	}
+	return files
+}
+
+func TestGenerate(t *testing.T) {
+	cfg := &restgen.Config{Endpoints: []restgen.Endpoint{
+		{Path: "/v1/widgets", Method: "ListWidgets", Client: "ReferenceClient", File: "widgets", Result: "Widget", Doc: "retrieves widgets."},
+		{Path: "/v1/widgets/{widget_id}/{date}", Method: "GetWidget", Client: "VXClient", File: "widgets", Doc: "retrieves a widget."},
+	}}
+	out := files(t, cfg, nil)
+	assert.Len(t, out, 3)
+
+	models := out["models/widgets_gen.go"]
+	assert.True(t, strings.HasPrefix(models, restgen.Header))
+	for _, s := range []string{
+		"type ListWidgetsParams struct {",
+		"TickerEQ  *string `query:\"ticker\"`",
+		"TickerLT  *string `query:\"ticker.lt\"`",
+		"TickerGTE *string `query:\"ticker.gte\"`",
+		"HasXBRL *bool `query:\"has_xbrl\"`",
+		"func (p ListWidgetsParams) WithTicker(c Comparator, q string) *ListWidgetsParams {",
+		"func (p ListWidgetsParams) WithLimit(q int) *ListWidgetsParams {",
+		"type Widget struct {",
+		"SizeBytes int64 `json:\"size_bytes,omitempty\"`",
+		"Owner WidgetOwner `json:\"owner,omitempty\"`",
+		"Results []Widget `json:\"results,omitempty\"`",
+		"WidgetID string `validate:\"required\" path:\"widget_id\"`",
+		"Date Date `validate:\"required\" path:\"date\"`",
+		"Price float64 `json:\"price,omitempty\"`",
+	} {
+		assert.Contains(t, models, s)
+	}
+	assert.NotContains(t, models, "TickerLTE")
+
+	client := out["widgets_gen.go"]
+	assert.Contains(t, client, "ListWidgetsPath = \"/v1/widgets\"")
+	assert.Contains(t, client, "func (c *ReferenceClient) ListWidgets(ctx context.Context, params *models.ListWidgetsParams, options ...models.RequestOption) *iter.Iter[models.Widget] {")
+	assert.Contains(t, client, "func (c *VXClient) GetWidget(ctx context.Context, params *models.GetWidgetParams, options ...models.RequestOption) (*models.GetWidgetResponse, error) {")
+
+	tests := out["widgets_gen_test.go"]
+	assert.Contains(t, tests, "registerResponder(\"https://api.massive.com/v1/widgets\", expectedResponse)")
+	assert.Contains(t, tests, "registerResponder(\"https://api.massive.com/v1/widgets/abc/2021-07-22\", expectedResponse)")
+	assert.Contains(t, tests, "c.VX.GetWidget(context.Background(), &models.GetWidgetParams{")
+	assert.Contains(t, tests, "Date:     models.Date(time.Date(2021, 7, 22, 0, 0, 0, 0, time.UTC)),")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	_, err := restgen.Generate(loadSpec(t), &restgen.Config{Endpoints: []restgen.Endpoint{
+		{Path: "/v1/widgets", Method: "ListWidgets", Client: "ReferenceClient", File: "widgets", Result: "Widget"},
+	}}, map[string]bool{"Widget": true})
+	assert.ErrorContains(t, err, "type Widget is already defined")
+
+	_, err = restgen.Generate(loadSpec(t), &restgen.Config{Endpoints: []restgen.Endpoint{
+		{Path: "/v1/widgets", Method: "ListWidgets", Client: "ReferenceClient", File: "widgets"},
+	}}, nil)
+	assert.ErrorContains(t, err, "result is required")
+
+	_, err = restgen.Generate(loadSpec(t), &restgen.Config{Endpoints: []restgen.Endpoint{
+		{Path: "/v1/missing", Method: "GetMissing", Client: "ReferenceClient", File: "missing"},
+	}}, nil)
+	assert.ErrorContains(t, err, "path isn't in the spec")
+}
+
+func TestDrift(t *testing.T) {
+	drift := restgen.Drift(loadSpec(t), []string{"/v1/widgets/{id}/{date}"})
+	assert.Equal(t, []restgen.Uncovered{
+		{Path: "/v1/gadgets", OperationID: "ListGadgets", Summary: "Gadgets", Draft: true},
+		{Path: "/v1/widgets", OperationID: "ListWidgets", Summary: "Widgets"},
+	}, drift)
+
+	var report bytes.Buffer
+	assert.Nil(t, restgen.WriteReport(&report, drift))
+	assert.Contains(t, report.String(), "| `/v1/gadgets` | ListGadgets | Gadgets (draft) |")
+}
+
+// TestDriftReport checks that the committed drift report matches the spec and the client.
+func TestDriftReport(t *testing.T) {
+	spec, err := restgen.LoadSpec("../../.massive/rest.json")
+	require.Nil(t, err)
+	paths, err := restgen.ClientPaths("../../rest")
+	require.Nil(t, err)
+
+	var report bytes.Buffer
+	assert.Nil(t, restgen.WriteReport(&report, restgen.Drift(spec, paths)))
+
+	committed, err := os.ReadFile("../../.massive/DRIFT.md")
+	require.Nil(t, err)
+	assert.Equal(t, string(committed), report.String(), "the drift report is out of date, run make generate")
+}
@@ -0,0 +1,109 @@
+// Package restgen generates params, response models, client methods and tests of the REST client from the OpenAPI
+// spec in .massive/rest.json, and reports the spec paths that the client doesn't cover.
+package restgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// Spec is the subset of an OpenAPI spec that the generator uses.
+type Spec struct {
+	Paths map[string]PathItem `json:"paths"`
+}
+
+// PathItem is a path of the spec.
+type PathItem struct {
+	Get *Operation `json:"get"`
+
+	// Ignore marks duplicates of a path for other markets (e.g. /v3/trades/{cryptoTicker}), which the client covers
+	// with a single method.
+	Ignore bool `json:"x-polygon-ignore"`
+
+	// Draft marks paths of APIs that aren't released yet.
+	Draft bool `json:"x-polygon-draft"`
+}
+
+// Operation is a method of a path.
+type Operation struct {
+	OperationID  string              `json:"operationId"`
+	Summary      string              `json:"summary"`
+	Description  string              `json:"description"`
+	Parameters   []Parameter         `json:"parameters"`
+	Responses    map[string]Response `json:"responses"`
+	Experimental json.RawMessage     `json:"x-polygon-experimental"`
+}
+
+// Parameter is a path or query parameter of an operation.
+type Parameter struct {
+	Name        string          `json:"name"`
+	In          string          `json:"in"`
+	Description string          `json:"description"`
+	Required    bool            `json:"required"`
+	Schema      *Schema         `json:"schema"`
+	Example     json.RawMessage `json:"example"`
+	GoID        string          `json:"x-polygon-go-id"`
+	Filter      *struct {
+		Range  bool `json:"range"`
+		Search bool `json:"search"`
+	} `json:"x-polygon-filter-field"`
+}
+
+// Response is a response of an operation.
+type Response struct {
+	Description string `json:"description"`
+	Content     map[string]struct {
+		Schema  *Schema         `json:"schema"`
+		Example json.RawMessage `json:"example"`
+	} `json:"content"`
+}
+
+// Schema is a JSON schema.
+type Schema struct {
+	Type        string             `json:"type"`
+	Format      string             `json:"format"`
+	Description string             `json:"description"`
+	Properties  map[string]*Schema `json:"properties"`
+	Items       *Schema            `json:"items"`
+	Enum        []json.RawMessage  `json:"enum"`
+	Example     json.RawMessage    `json:"example"`
+}
+
+// LoadSpec reads a spec from a JSON file.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to decode spec %s: %w", path, err)
+	}
+	return &spec, nil
+}
+
+// JSON returns the schema and example of the successful JSON response of an operation.
+func (o *Operation) JSON() (*Schema, json.RawMessage) {
+	res, ok := o.Responses["200"]
+	if !ok {
+		return nil, nil
+	}
+	content, ok := res.Content["application/json"]
+	if !ok {
+		return nil, nil
+	}
+	return content.Schema, content.Example
+}
+
+// propertyNames returns the names of the schema's properties in sorted order.
+func (s *Schema) propertyNames() []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
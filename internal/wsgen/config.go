@@ -0,0 +1,87 @@
+package wsgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Config maps the channels of the spec to the topics and models of the WebSocket client.
+type Config struct {
+	Markets []Market `json:"markets"`
+	Topics  []Topic  `json:"topics"`
+	Models  []Model  `json:"models"`
+}
+
+// Market maps the market segment of channels (e.g. "stocks" in "/launchpad/stocks/AM") to Market constants.
+type Market struct {
+	Segment string   `json:"segment"`
+	Names   []string `json:"names"`
+}
+
+// Topic configures a Topic constant.
+type Topic struct {
+	// Name is the name of the constant, e.g. "StocksTrades".
+	Name string `json:"name"`
+
+	// Value is the value of the constant. Values of existing topics must not change.
+	Value int `json:"value"`
+
+	// Channels are the channels of the topic, e.g. ["/stocks/T"]. The last segment of a channel is the prefix of the
+	// topic and the segment before it is its market.
+	Channels []string `json:"channels"`
+
+	// Unspecified marks topics whose channels aren't in the spec yet (e.g. futures), which are generated as is.
+	Unspecified bool `json:"unspecified,omitempty"`
+}
+
+// Model configures an event model of the websocket/models package.
+type Model struct {
+	// Name is the name of the model, e.g. "EquityTrade".
+	Name string `json:"name"`
+
+	// Doc completes the model's doc comment after its name, e.g. "is a trade for a crypto pair."
+	Doc string `json:"doc"`
+
+	// Channels are the channels whose events are decoded into the model. Its fields are the union of their
+	// properties.
+	Channels []string `json:"channels"`
+
+	// Fields maps properties to field names, e.g. {"sym": "Symbol"}. Every property of the channels must be mapped;
+	// "-" skips a property and "EventType" embeds the EventType model.
+	Fields map[string]string `json:"fields"`
+
+	// Types overrides the Go types of fields by property, e.g. {"x": "int32"}. Properties without a type in the spec
+	// must have one.
+	Types map[string]string `json:"types,omitempty"`
+
+	// Docs overrides the doc comments of fields by property.
+	Docs map[string]string `json:"docs,omitempty"`
+
+	// Required lists the properties whose fields are encoded even if they're zero, i.e. without omitempty.
+	Required []string `json:"required,omitempty"`
+}
+
+// LoadConfig reads a config from a JSON file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode config %s: %w", path, err)
+	}
+	for _, t := range cfg.Topics {
+		if t.Name == "" || len(t.Channels) == 0 {
+			return nil, fmt.Errorf("topic %q: name and channels are required", t.Name)
+		}
+	}
+	for _, m := range cfg.Models {
+		if m.Name == "" || len(m.Channels) == 0 {
+			return nil, fmt.Errorf("model %q: name and channels are required", m.Name)
+		}
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,298 @@
+package wsgen
+
+import (
+	"fmt"
+	"go/format"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/massive-com/client-go/v2/internal/restgen"
+)
+
+// Header starts every generated file.
+const Header = "// Code generated by wsgen from .massive/websocket.json; DO NOT EDIT."
+
+// File is a generated file.
+type File struct {
+	// Path is the path of the file relative to the websocket package, e.g. "models/models_gen.go".
+	Path    string
+	Content []byte
+}
+
+// Generate returns the topics file of the websocket package and the models file of the websocket/models package.
+// It fails if the spec and the config disagree, e.g. if a channel of the spec isn't mapped to a topic and a model,
+// or a property of a channel isn't mapped to a field.
+func Generate(spec *Spec, cfg *Config) ([]File, error) {
+	if err := checkChannels(spec, cfg); err != nil {
+		return nil, err
+	}
+
+	topics, err := topicsFile(cfg)
+	if err != nil {
+		return nil, err
+	}
+	models, err := modelsFile(spec, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return []File{topics, models}, nil
+}
+
+// checkChannels checks that every channel of the spec has exactly one topic and one model, and that the config
+// only refers to channels of the spec.
+func checkChannels(spec *Spec, cfg *Config) error {
+	topics := make(map[string]string)
+	for _, t := range cfg.Topics {
+		for _, ch := range t.Channels {
+			if other, ok := topics[ch]; ok {
+				return fmt.Errorf("channel %s is mapped to topics %s and %s", ch, other, t.Name)
+			}
+			topics[ch] = t.Name
+			if _, ok := spec.Paths[ch]; !ok && !t.Unspecified {
+				return fmt.Errorf("topic %s: channel %s isn't in the spec", t.Name, ch)
+			}
+		}
+	}
+
+	models := make(map[string]string)
+	for _, m := range cfg.Models {
+		for _, ch := range m.Channels {
+			if other, ok := models[ch]; ok {
+				return fmt.Errorf("channel %s is mapped to models %s and %s", ch, other, m.Name)
+			}
+			models[ch] = m.Name
+			if spec.event(ch) == nil {
+				return fmt.Errorf("model %s: channel %s isn't in the spec", m.Name, ch)
+			}
+		}
+	}
+
+	for _, ch := range sortedChannels(spec) {
+		if _, ok := topics[ch]; !ok {
+			return fmt.Errorf("channel %s isn't mapped to a topic", ch)
+		}
+		if _, ok := models[ch]; !ok {
+			return fmt.Errorf("channel %s isn't mapped to a model", ch)
+		}
+	}
+	return nil
+}
+
+func sortedChannels(spec *Spec) []string {
+	channels := make([]string, 0, len(spec.Paths))
+	for ch := range spec.Paths {
+		channels = append(channels, ch)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
+// split returns the market segment and the prefix of a channel, e.g. "stocks" and "AM" for "/launchpad/stocks/AM".
+func split(channel string) (string, string, error) {
+	segments := strings.Split(strings.Trim(channel, "/"), "/")
+	if len(segments) < 2 {
+		return "", "", fmt.Errorf("invalid channel %s", channel)
+	}
+	return segments[len(segments)-2], segments[len(segments)-1], nil
+}
+
+func topicsFile(cfg *Config) (File, error) {
+	markets := make(map[string][]string)
+	for _, m := range cfg.Markets {
+		markets[m.Segment] = m.Names
+	}
+
+	topics := append([]Topic{}, cfg.Topics...)
+	sort.SliceStable(topics, func(i, j int) bool { return topics[i].Value < topics[j].Value })
+
+	var consts, prefixes strings.Builder
+	supported := make(map[string][]string)
+	for i, t := range topics {
+		if t.Value < 1 || t.Value > 255 {
+			return File{}, fmt.Errorf("topic %s: value %d is out of range", t.Name, t.Value)
+		}
+		if i > 0 {
+			switch prev := topics[i-1].Value; {
+			case prev == t.Value:
+				return File{}, fmt.Errorf("topics %s and %s have the same value", topics[i-1].Name, t.Name)
+			case prev+1 < t.Value:
+				consts.WriteString("\n")
+			}
+		}
+		fmt.Fprintf(&consts, "\t%s Topic = %d\n", t.Name, t.Value)
+
+		var prefix string
+		for _, ch := range t.Channels {
+			segment, p, err := split(ch)
+			if err != nil {
+				return File{}, fmt.Errorf("topic %s: %w", t.Name, err)
+			}
+			if prefix != "" && p != prefix {
+				return File{}, fmt.Errorf("topic %s: channels have different prefixes %s and %s", t.Name, prefix, p)
+			}
+			prefix = p
+
+			names, ok := markets[segment]
+			if !ok {
+				return File{}, fmt.Errorf("topic %s: market %s of channel %s isn't in the config", t.Name, segment, ch)
+			}
+			for _, name := range names {
+				supported[name] = append(supported[name], t.Name)
+			}
+		}
+		fmt.Fprintf(&prefixes, "\tcase %s:\n\t\treturn %q\n", t.Name, prefix)
+	}
+
+	var matrix strings.Builder
+	for _, m := range cfg.Markets {
+		for _, name := range m.Names {
+			fmt.Fprintf(&matrix, "\t%s: {\n", name)
+			for _, t := range supported[name] {
+				fmt.Fprintf(&matrix, "\t\t%s,\n", t)
+			}
+			matrix.WriteString("\t},\n")
+		}
+	}
+
+	src := fmt.Sprintf(`%s
+
+package massivews
+
+const (
+%s)
+
+func (t Topic) prefix() string {
+	switch t {
+%s	}
+	return ""
+}
+
+// marketTopics are the topics that each market supports.
+var marketTopics = map[Market][]Topic{
+%s}
+`, Header, consts.String(), prefixes.String(), matrix.String())
+	return gofmt("topics_gen.go", src)
+}
+
+func modelsFile(spec *Spec, cfg *Config) (File, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\npackage models\n", Header)
+	for _, m := range cfg.Models {
+		model, err := generateModel(spec, m)
+		if err != nil {
+			return File{}, fmt.Errorf("model %s: %w", m.Name, err)
+		}
+		b.WriteString(model)
+	}
+	return gofmt("models/models_gen.go", b.String())
+}
+
+func generateModel(spec *Spec, m Model) (string, error) {
+	var props Properties
+	seen := make(map[string]bool)
+	for _, ch := range m.Channels {
+		for _, p := range spec.event(ch).properties() {
+			if seen[p.Name] {
+				continue
+			}
+			if _, ok := m.Fields[p.Name]; !ok {
+				return "", fmt.Errorf("property %s of channel %s isn't mapped to a field", p.Name, ch)
+			}
+			seen[p.Name] = true
+			props = append(props, p)
+		}
+	}
+	for _, prop := range sortedKeys(m.Fields) {
+		if !seen[prop] {
+			return "", fmt.Errorf("field of property %s isn't in the spec", prop)
+		}
+	}
+	for _, prop := range m.Required {
+		if !seen[prop] {
+			return "", fmt.Errorf("required property %s isn't in the spec", prop)
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n// %s %s\ntype %s struct {", m.Name, m.Doc, m.Name)
+	for _, p := range props {
+		field := m.Fields[p.Name]
+		if field == "-" {
+			continue
+		}
+
+		description := p.Schema.Description
+		if doc, ok := m.Docs[p.Name]; ok {
+			description = doc
+		}
+		b.WriteString("\n" + restgen.Comment(rebrand(description), "\t"))
+
+		if field == "EventType" {
+			b.WriteString("\tEventType\n")
+			continue
+		}
+		typ, ok := m.Types[p.Name]
+		if !ok {
+			if typ, ok = goType(p.Schema); !ok {
+				return "", fmt.Errorf("property %s has no type", p.Name)
+			}
+		}
+		tag := p.Name + ",omitempty"
+		if slices.Contains(m.Required, p.Name) {
+			tag = p.Name
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:\"%s\"`\n", field, typ, tag)
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// goType returns the Go type of a schema.
+func goType(s *Schema) (string, bool) {
+	switch s.Type {
+	case "integer":
+		return "int64", true
+	case "number":
+		return "float64", true
+	case "string":
+		return "string", true
+	case "boolean":
+		return "bool", true
+	case "array":
+		if s.Items == nil {
+			return "", false
+		}
+		typ, ok := goType(s.Items)
+		return "[]" + typ, ok
+	}
+	return "", false
+}
+
+var (
+	markdownLink = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	rebrander    = strings.NewReplacer("Polygon.io", "Massive", "Polygon", "Massive")
+)
+
+// rebrand removes the links of a description and replaces the former brand of the API.
+func rebrand(description string) string {
+	return rebrander.Replace(markdownLink.ReplaceAllString(description, "$1"))
+}
+
+func gofmt(path, src string) (File, error) {
+	content, err := format.Source([]byte(src))
+	if err != nil {
+		return File{}, fmt.Errorf("failed to format %s: %w", path, err)
+	}
+	return File{Path: path, Content: content}, nil
+}
@@ -0,0 +1,97 @@
+// Package wsgen generates the topics, prefixes, market support matrix and event models of the WebSocket client from
+// the spec in .massive/websocket.json.
+package wsgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Spec is the subset of the WebSocket spec that the generator uses. Its paths are the channels of the API, e.g.
+// "/stocks/T" or "/launchpad/crypto/LV".
+type Spec struct {
+	Paths map[string]struct {
+		Get *struct {
+			Summary   string `json:"summary"`
+			Responses map[string]struct {
+				Content map[string]struct {
+					Schema *Schema `json:"schema"`
+				} `json:"content"`
+			} `json:"responses"`
+		} `json:"get"`
+	} `json:"paths"`
+}
+
+// Schema is a JSON schema.
+type Schema struct {
+	Type        string     `json:"type"`
+	Format      string     `json:"format"`
+	Description string     `json:"description"`
+	Properties  Properties `json:"properties"`
+	Items       *Schema    `json:"items"`
+	AllOf       []*Schema  `json:"allOf"`
+}
+
+// Property is a property of a schema.
+type Property struct {
+	Name   string
+	Schema *Schema
+}
+
+// Properties are the properties of a schema in the order of the spec, which is the order of the generated fields.
+type Properties []Property
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Properties) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var s Schema
+		if err := dec.Decode(&s); err != nil {
+			return err
+		}
+		*p = append(*p, Property{Name: tok.(string), Schema: &s})
+	}
+	_, err := dec.Token()
+	return err
+}
+
+// LoadSpec reads a spec from a JSON file.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to decode spec %s: %w", path, err)
+	}
+	return &spec, nil
+}
+
+// event returns the schema of the messages of a channel.
+func (s *Spec) event(channel string) *Schema {
+	item, ok := s.Paths[channel]
+	if !ok || item.Get == nil {
+		return nil
+	}
+	return item.Get.Responses["200"].Content["application/json"].Schema
+}
+
+// properties returns the properties of the schema, including the properties of the schemas it's composed of.
+func (s *Schema) properties() Properties {
+	props := append(Properties{}, s.Properties...)
+	for _, sub := range s.AllOf {
+		props = append(props, sub.properties()...)
+	}
+	return props
+}
@@ -0,0 +1,148 @@
+package wsgen_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/massive-com/client-go/v2/internal/wsgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const spec = `{
+	"paths": {
+		"/widgets/W": {"get": {"responses": {"200": {"content": {"application/json": {"schema": {
+			"type": "object",
+			"properties": {
+				"ev": {"description": "The event type."},
+				"sym": {"type": "string", "description": "The ticker symbol. See <a href=\"https://polygon.io\">Tickers</a> for Polygon.io's tickers."},
+				"p": {"type": "number", "description": "The price."},
+				"c": {"type": "array", "items": {"type": "integer"}},
+				"x": {"type": "integer"}
+			}
+		}}}}}}},
+		"/launchpad/widgets/W": {"get": {"responses": {"200": {"content": {"application/json": {"schema": {
+			"allOf": [{"type": "object", "properties": {"ev": {}, "sym": {"type": "string"}, "val": {"description": "The value."}}}]
+		}}}}}}},
+		"/gadgets/W": {"get": {"responses": {"200": {"content": {"application/json": {"schema": {
+			"type": "object",
+			"properties": {"ev": {}, "sym": {"type": "string"}, "p": {"type": "number"}}
+		}}}}}}}
+	}
+}`
+
+const config = `{
+	"markets": [
+		{"segment": "widgets", "names": ["Widgets"]},
+		{"segment": "gadgets", "names": ["Gadgets", "GadgetsUS"]}
+	],
+	"topics": [
+		{"name": "WidgetsLaunchpadValue", "value": 12, "channels": ["/launchpad/widgets/W"]},
+		{"name": "WidgetsTrades", "value": 11, "channels": ["/widgets/W"]},
+		{"name": "GadgetsTrades", "value": 21, "channels": ["/gadgets/W"]},
+		{"name": "GadgetsQuotes", "value": 22, "channels": ["/gadgets/Q"], "unspecified": true}
+	],
+	"models": [
+		{
+			"name": "Trade",
+			"doc": "is a trade.",
+			"channels": ["/widgets/W", "/gadgets/W"],
+			"fields": {"ev": "EventType", "sym": "Symbol", "p": "Price", "c": "Conditions", "x": "-"},
+			"types": {"c": "[]int32"}
+		},
+		{
+			"name": "Value",
+			"doc": "is a value.",
+			"channels": ["/launchpad/widgets/W"],
+			"fields": {"ev": "EventType", "sym": "Symbol", "val": "Value"},
+			"types": {"val": "float64"},
+			"docs": {"sym": "The symbol."},
+			"required": ["val"]
+		}
+	]
+}`
+
+func load(t *testing.T, specJSON, configJSON string) (*wsgen.Spec, *wsgen.Config) {
+	var s wsgen.Spec
+	require.Nil(t, json.Unmarshal([]byte(specJSON), &s))
+	var c wsgen.Config
+	require.Nil(t, json.Unmarshal([]byte(configJSON), &c))
+	return &s, &c
+}
+
+func TestGenerate(t *testing.T) {
+	files, err := wsgen.Generate(load(t, spec, config))
+	require.Nil(t, err)
+	require.Len(t, files, 2)
+
+	assert.Equal(t, "topics_gen.go", files[0].Path)
+	topics := string(files[0].Content)
+	assert.True(t, strings.HasPrefix(topics, wsgen.Header))
+	assert.Contains(t, topics, "\tWidgetsTrades         Topic = 11\n\tWidgetsLaunchpadValue Topic = 12\n\n\tGadgetsTrades Topic = 21\n")
+	assert.Contains(t, topics, "\tcase WidgetsLaunchpadValue:\n\t\treturn \"W\"\n")
+	assert.Contains(t, topics, "\tcase GadgetsQuotes:\n\t\treturn \"Q\"\n")
+	assert.Contains(t, topics, "\tWidgets: {\n\t\tWidgetsTrades,\n\t\tWidgetsLaunchpadValue,\n\t},\n")
+	assert.Contains(t, topics, "\tGadgetsUS: {\n\t\tGadgetsTrades,\n\t\tGadgetsQuotes,\n\t},\n")
+
+	assert.Equal(t, "models/models_gen.go", files[1].Path)
+	models := string(files[1].Content)
+	assert.Contains(t, models, `// Trade is a trade.
+type Trade struct {
+	// The event type.
+	EventType
+
+	// The ticker symbol. See Tickers for Massive's tickers.
+	Symbol string `+"`json:\"sym,omitempty\"`"+`
+
+	// The price.
+	Price float64 `+"`json:\"p,omitempty\"`"+`
+
+	Conditions []int32 `+"`json:\"c,omitempty\"`"+`
+}
+`)
+	assert.Contains(t, models, "\t// The symbol.\n\tSymbol string `json:\"sym,omitempty\"`\n\n\t// The value.\n\tValue float64 `json:\"val\"`\n")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := map[string]struct {
+		replace, with string
+		err           string
+	}{
+		"unmapped topic channel": {`"channels": ["/gadgets/W"]}`, `"channels": []}`, "channel /gadgets/W isn't mapped to a topic"},
+		"unknown topic channel":  {`["/gadgets/Q"], "unspecified": true`, `["/gadgets/Q"]`, "topic GadgetsQuotes: channel /gadgets/Q isn't in the spec"},
+		"unmapped model channel": {`["/widgets/W", "/gadgets/W"]`, `["/widgets/W"]`, "channel /gadgets/W isn't mapped to a model"},
+		"unmapped property":      {`"x": "-"`, `"y": "-"`, "model Trade: property x of channel /widgets/W isn't mapped to a field"},
+		"unknown required":       {`"required": ["val"]`, `"required": ["p"]`, "model Value: required property p isn't in the spec"},
+		"missing type":           {`"types": {"val": "float64"}`, `"types": {}`, "model Value: property val has no type"},
+		"duplicate value":        {`"value": 22`, `"value": 21`, "topics GadgetsTrades and GadgetsQuotes have the same value"},
+		"unknown market":         {`{"segment": "widgets", "names": ["Widgets"]},`, ``, "market widgets of channel /widgets/W isn't in the config"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := wsgen.Generate(load(t, spec, strings.Replace(config, tc.replace, tc.with, 1)))
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+// TestGenerated checks that the committed topics and models match the spec, so that it fails when the spec changes
+// until the config is updated and the code is regenerated.
+func TestGenerated(t *testing.T) {
+	s, err := wsgen.LoadSpec("../../.massive/websocket.json")
+	require.Nil(t, err)
+	c, err := wsgen.LoadConfig("../../.massive/topics.json")
+	require.Nil(t, err)
+
+	files, err := wsgen.Generate(s, c)
+	require.Nil(t, err, "the spec and .massive/topics.json disagree")
+	for _, f := range files {
+		committed, err := os.ReadFile(filepath.Join("../../websocket", f.Path))
+		require.Nil(t, err)
+		assert.Equal(t, string(committed), string(f.Content), "%s is out of date, run make generate", f.Path)
+	}
+}
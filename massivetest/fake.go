@@ -0,0 +1,43 @@
+package massivetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+//go:generate go run ../.massive/fakes.go ../rest fakes.go
+
+// ErrNotImplemented is returned by the methods of fakes (e.g. FakeClient) whose function field isn't set.
+var ErrNotImplemented = errors.New("massivetest: method not implemented")
+
+func notImplemented(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotImplemented, method)
+}
+
+// Iter returns an iterator over in-memory results, e.g. for the list methods of fakes. If err isn't nil, the
+// iterator returns it after the results.
+func Iter[T any](results []T, err error) *iter.Iter[T] {
+	return iter.Resume(context.Background(), models.Cursor{URL: "results"}, func(_ context.Context, uri string) (iter.ListResponse, []T, error) {
+		if uri == "results" {
+			next := ""
+			if err != nil {
+				next = "error"
+			}
+			return listResponse{next: next}, results, nil
+		}
+		return listResponse{}, nil, err
+	})
+}
+
+// listResponse is the response of an in-memory iterator.
+type listResponse struct {
+	next string
+}
+
+func (r listResponse) NextPage() string {
+	return r.next
+}
@@ -0,0 +1,56 @@
+package massivetest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/massive-com/client-go/v2/massivetest"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func lastPrice(ctx context.Context, c massive.API, ticker string) (float64, error) {
+	res, err := c.GetLastTrade(ctx, &models.GetLastTradeParams{Ticker: ticker})
+	if err != nil {
+		return 0, err
+	}
+	return res.Results.Price, nil
+}
+
+func TestFakeClient(t *testing.T) {
+	fake := &massivetest.FakeClient{}
+	fake.GetLastTradeFunc = func(_ context.Context, params *models.GetLastTradeParams, _ ...models.RequestOption) (*models.GetLastTradeResponse, error) {
+		res := &models.GetLastTradeResponse{}
+		res.Results.Ticker = params.Ticker
+		res.Results.Price = 171.55
+		return res, nil
+	}
+	fake.ListTradesFunc = func(_ context.Context, _ *models.ListTradesParams, _ ...models.RequestOption) *iter.Iter[models.Trade] {
+		return massivetest.Iter([]models.Trade{{ID: "1"}, {ID: "2"}}, nil)
+	}
+
+	price, err := lastPrice(context.Background(), fake, "AAPL")
+	assert.Nil(t, err)
+	assert.Equal(t, 171.55, price)
+
+	trades, err := iter.Collect(fake.ListTrades(context.Background(), &models.ListTradesParams{Ticker: "AAPL"}).All())
+	assert.Nil(t, err)
+	assert.Equal(t, []models.Trade{{ID: "1"}, {ID: "2"}}, trades)
+
+	_, err = fake.GetLastQuote(context.Background(), &models.GetLastQuoteParams{Ticker: "AAPL"})
+	assert.True(t, errors.Is(err, massivetest.ErrNotImplemented))
+
+	it := fake.VX.ListIPOs(context.Background(), &models.ListIPOsParams{})
+	assert.False(t, it.Next())
+	assert.True(t, errors.Is(it.Err(), massivetest.ErrNotImplemented))
+}
+
+func TestIterError(t *testing.T) {
+	boom := errors.New("boom")
+	trades, err := iter.Collect(massivetest.Iter([]models.Trade{{ID: "1"}}, boom).All())
+	assert.Equal(t, boom, err)
+	assert.Equal(t, []models.Trade{{ID: "1"}}, trades)
+}
@@ -0,0 +1,705 @@
+// Code generated by .massive/fakes.go; DO NOT EDIT.
+
+package massivetest
+
+import (
+	"context"
+	"io"
+
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// FakeAggs is a fake massive.AggsAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeAggs struct {
+	ListAggsFunc                func(ctx context.Context, params *models.ListAggsParams, options ...models.RequestOption) *iter.Iter[models.Agg]
+	ListAggsShardedFunc         func(ctx context.Context, params *models.ListAggsParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Agg]
+	GetAggsFunc                 func(ctx context.Context, params *models.GetAggsParams, options ...models.RequestOption) (*models.GetAggsResponse, error)
+	GetGroupedDailyAggsFunc     func(ctx context.Context, params *models.GetGroupedDailyAggsParams, options ...models.RequestOption) (*models.GetGroupedDailyAggsResponse, error)
+	GetDailyOpenCloseAggFunc    func(ctx context.Context, params *models.GetDailyOpenCloseAggParams, options ...models.RequestOption) (*models.GetDailyOpenCloseAggResponse, error)
+	GetCryptoDailyOpenCloseFunc func(ctx context.Context, params *models.GetCryptoDailyOpenCloseParams, options ...models.RequestOption) (*models.GetCryptoDailyOpenCloseResponse, error)
+	GetPreviousCloseAggFunc     func(ctx context.Context, params *models.GetPreviousCloseAggParams, options ...models.RequestOption) (*models.GetPreviousCloseAggResponse, error)
+}
+
+// ListAggs calls ListAggsFunc.
+func (f *FakeAggs) ListAggs(ctx context.Context, params *models.ListAggsParams, options ...models.RequestOption) *iter.Iter[models.Agg] {
+	if f.ListAggsFunc == nil {
+		return Iter[models.Agg](nil, notImplemented("AggsAPI.ListAggs"))
+	}
+	return f.ListAggsFunc(ctx, params, options...)
+}
+
+// ListAggsSharded calls ListAggsShardedFunc.
+func (f *FakeAggs) ListAggsSharded(ctx context.Context, params *models.ListAggsParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Agg] {
+	if f.ListAggsShardedFunc == nil {
+		return Iter[models.Agg](nil, notImplemented("AggsAPI.ListAggsSharded"))
+	}
+	return f.ListAggsShardedFunc(ctx, params, shards, workers, options...)
+}
+
+// GetAggs calls GetAggsFunc.
+func (f *FakeAggs) GetAggs(ctx context.Context, params *models.GetAggsParams, options ...models.RequestOption) (*models.GetAggsResponse, error) {
+	if f.GetAggsFunc == nil {
+		return nil, notImplemented("AggsAPI.GetAggs")
+	}
+	return f.GetAggsFunc(ctx, params, options...)
+}
+
+// GetGroupedDailyAggs calls GetGroupedDailyAggsFunc.
+func (f *FakeAggs) GetGroupedDailyAggs(ctx context.Context, params *models.GetGroupedDailyAggsParams, options ...models.RequestOption) (*models.GetGroupedDailyAggsResponse, error) {
+	if f.GetGroupedDailyAggsFunc == nil {
+		return nil, notImplemented("AggsAPI.GetGroupedDailyAggs")
+	}
+	return f.GetGroupedDailyAggsFunc(ctx, params, options...)
+}
+
+// GetDailyOpenCloseAgg calls GetDailyOpenCloseAggFunc.
+func (f *FakeAggs) GetDailyOpenCloseAgg(ctx context.Context, params *models.GetDailyOpenCloseAggParams, options ...models.RequestOption) (*models.GetDailyOpenCloseAggResponse, error) {
+	if f.GetDailyOpenCloseAggFunc == nil {
+		return nil, notImplemented("AggsAPI.GetDailyOpenCloseAgg")
+	}
+	return f.GetDailyOpenCloseAggFunc(ctx, params, options...)
+}
+
+// GetCryptoDailyOpenClose calls GetCryptoDailyOpenCloseFunc.
+func (f *FakeAggs) GetCryptoDailyOpenClose(ctx context.Context, params *models.GetCryptoDailyOpenCloseParams, options ...models.RequestOption) (*models.GetCryptoDailyOpenCloseResponse, error) {
+	if f.GetCryptoDailyOpenCloseFunc == nil {
+		return nil, notImplemented("AggsAPI.GetCryptoDailyOpenClose")
+	}
+	return f.GetCryptoDailyOpenCloseFunc(ctx, params, options...)
+}
+
+// GetPreviousCloseAgg calls GetPreviousCloseAggFunc.
+func (f *FakeAggs) GetPreviousCloseAgg(ctx context.Context, params *models.GetPreviousCloseAggParams, options ...models.RequestOption) (*models.GetPreviousCloseAggResponse, error) {
+	if f.GetPreviousCloseAggFunc == nil {
+		return nil, notImplemented("AggsAPI.GetPreviousCloseAgg")
+	}
+	return f.GetPreviousCloseAggFunc(ctx, params, options...)
+}
+
+// FakeQuotes is a fake massive.QuotesAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeQuotes struct {
+	ListQuotesFunc                    func(ctx context.Context, params *models.ListQuotesParams, options ...models.RequestOption) *iter.Iter[models.Quote]
+	ListQuotesShardedFunc             func(ctx context.Context, params *models.ListQuotesParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Quote]
+	GetLastQuoteFunc                  func(ctx context.Context, params *models.GetLastQuoteParams, options ...models.RequestOption) (*models.GetLastQuoteResponse, error)
+	GetLastForexQuoteFunc             func(ctx context.Context, params *models.GetLastForexQuoteParams, options ...models.RequestOption) (*models.GetLastForexQuoteResponse, error)
+	GetRealTimeCurrencyConversionFunc func(ctx context.Context, params *models.GetRealTimeCurrencyConversionParams, options ...models.RequestOption) (*models.GetRealTimeCurrencyConversionResponse, error)
+	ListHistoricQuotesFunc            func(ctx context.Context, params *models.ListHistoricQuotesParams, options ...models.RequestOption) *iter.Iter[models.LastQuote]
+	ListHistoricForexTicksFunc        func(ctx context.Context, params *models.ListHistoricForexTicksParams, options ...models.RequestOption) *iter.Iter[models.ForexTick]
+}
+
+// ListQuotes calls ListQuotesFunc.
+func (f *FakeQuotes) ListQuotes(ctx context.Context, params *models.ListQuotesParams, options ...models.RequestOption) *iter.Iter[models.Quote] {
+	if f.ListQuotesFunc == nil {
+		return Iter[models.Quote](nil, notImplemented("QuotesAPI.ListQuotes"))
+	}
+	return f.ListQuotesFunc(ctx, params, options...)
+}
+
+// ListQuotesSharded calls ListQuotesShardedFunc.
+func (f *FakeQuotes) ListQuotesSharded(ctx context.Context, params *models.ListQuotesParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Quote] {
+	if f.ListQuotesShardedFunc == nil {
+		return Iter[models.Quote](nil, notImplemented("QuotesAPI.ListQuotesSharded"))
+	}
+	return f.ListQuotesShardedFunc(ctx, params, shards, workers, options...)
+}
+
+// GetLastQuote calls GetLastQuoteFunc.
+func (f *FakeQuotes) GetLastQuote(ctx context.Context, params *models.GetLastQuoteParams, options ...models.RequestOption) (*models.GetLastQuoteResponse, error) {
+	if f.GetLastQuoteFunc == nil {
+		return nil, notImplemented("QuotesAPI.GetLastQuote")
+	}
+	return f.GetLastQuoteFunc(ctx, params, options...)
+}
+
+// GetLastForexQuote calls GetLastForexQuoteFunc.
+func (f *FakeQuotes) GetLastForexQuote(ctx context.Context, params *models.GetLastForexQuoteParams, options ...models.RequestOption) (*models.GetLastForexQuoteResponse, error) {
+	if f.GetLastForexQuoteFunc == nil {
+		return nil, notImplemented("QuotesAPI.GetLastForexQuote")
+	}
+	return f.GetLastForexQuoteFunc(ctx, params, options...)
+}
+
+// GetRealTimeCurrencyConversion calls GetRealTimeCurrencyConversionFunc.
+func (f *FakeQuotes) GetRealTimeCurrencyConversion(ctx context.Context, params *models.GetRealTimeCurrencyConversionParams, options ...models.RequestOption) (*models.GetRealTimeCurrencyConversionResponse, error) {
+	if f.GetRealTimeCurrencyConversionFunc == nil {
+		return nil, notImplemented("QuotesAPI.GetRealTimeCurrencyConversion")
+	}
+	return f.GetRealTimeCurrencyConversionFunc(ctx, params, options...)
+}
+
+// ListHistoricQuotes calls ListHistoricQuotesFunc.
+func (f *FakeQuotes) ListHistoricQuotes(ctx context.Context, params *models.ListHistoricQuotesParams, options ...models.RequestOption) *iter.Iter[models.LastQuote] {
+	if f.ListHistoricQuotesFunc == nil {
+		return Iter[models.LastQuote](nil, notImplemented("QuotesAPI.ListHistoricQuotes"))
+	}
+	return f.ListHistoricQuotesFunc(ctx, params, options...)
+}
+
+// ListHistoricForexTicks calls ListHistoricForexTicksFunc.
+func (f *FakeQuotes) ListHistoricForexTicks(ctx context.Context, params *models.ListHistoricForexTicksParams, options ...models.RequestOption) *iter.Iter[models.ForexTick] {
+	if f.ListHistoricForexTicksFunc == nil {
+		return Iter[models.ForexTick](nil, notImplemented("QuotesAPI.ListHistoricForexTicks"))
+	}
+	return f.ListHistoricForexTicksFunc(ctx, params, options...)
+}
+
+// FakeReference is a fake massive.ReferenceAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeReference struct {
+	ListTickersFunc               func(ctx context.Context, params *models.ListTickersParams, options ...models.RequestOption) *iter.Iter[models.Ticker]
+	GetTickerDetailsFunc          func(ctx context.Context, params *models.GetTickerDetailsParams, options ...models.RequestOption) (*models.GetTickerDetailsResponse, error)
+	ListTickerNewsFunc            func(ctx context.Context, params *models.ListTickerNewsParams, options ...models.RequestOption) *iter.Iter[models.TickerNews]
+	GetTickerRelatedCompaniesFunc func(ctx context.Context, params *models.GetTickerRelatedCompaniesParams, options ...models.RequestOption) (*models.GetTickerRelatedCompaniesResponse, error)
+	GetTickerTypesFunc            func(ctx context.Context, params *models.GetTickerTypesParams, options ...models.RequestOption) (*models.GetTickerTypesResponse, error)
+	GetMarketHolidaysFunc         func(ctx context.Context, options ...models.RequestOption) (*models.GetMarketHolidaysResponse, error)
+	GetMarketStatusFunc           func(ctx context.Context, options ...models.RequestOption) (*models.GetMarketStatusResponse, error)
+	ListSplitsFunc                func(ctx context.Context, params *models.ListSplitsParams, options ...models.RequestOption) *iter.Iter[models.Split]
+	ListDividendsFunc             func(ctx context.Context, params *models.ListDividendsParams, options ...models.RequestOption) *iter.Iter[models.Dividend]
+	ListConditionsFunc            func(ctx context.Context, params *models.ListConditionsParams, options ...models.RequestOption) *iter.Iter[models.Condition]
+	GetExchangesFunc              func(ctx context.Context, params *models.GetExchangesParams, options ...models.RequestOption) (*models.GetExchangesResponse, error)
+	GetOptionsContractFunc        func(ctx context.Context, params *models.GetOptionsContractParams, options ...models.RequestOption) (*models.GetOptionsContractResponse, error)
+	ListOptionsContractsFunc      func(ctx context.Context, params *models.ListOptionsContractsParams, options ...models.RequestOption) *iter.Iter[models.OptionsContract]
+	ListShortInterestFunc         func(ctx context.Context, params *models.ListShortInterestParams, options ...models.RequestOption) *iter.Iter[models.ShortInterest]
+	ListShortVolumeFunc           func(ctx context.Context, params *models.ListShortVolumeParams, options ...models.RequestOption) *iter.Iter[models.ShortVolume]
+	ListTreasuryYieldsFunc        func(ctx context.Context, params *models.ListTreasuryYieldsParams, options ...models.RequestOption) *iter.Iter[models.TreasuryYield]
+	ListSECFilingsFunc            func(ctx context.Context, params *models.ListSECFilingsParams, options ...models.RequestOption) *iter.Iter[models.SECFiling]
+	GetSECFilingFunc              func(ctx context.Context, params *models.GetSECFilingParams, options ...models.RequestOption) (*models.GetSECFilingResponse, error)
+	ListSECFilingFilesFunc        func(ctx context.Context, params *models.ListSECFilingFilesParams, options ...models.RequestOption) *iter.Iter[models.SECFilingFile]
+	GetSECFilingFileFunc          func(ctx context.Context, params *models.GetSECFilingFileParams, options ...models.RequestOption) (io.ReadCloser, error)
+}
+
+// ListTickers calls ListTickersFunc.
+func (f *FakeReference) ListTickers(ctx context.Context, params *models.ListTickersParams, options ...models.RequestOption) *iter.Iter[models.Ticker] {
+	if f.ListTickersFunc == nil {
+		return Iter[models.Ticker](nil, notImplemented("ReferenceAPI.ListTickers"))
+	}
+	return f.ListTickersFunc(ctx, params, options...)
+}
+
+// GetTickerDetails calls GetTickerDetailsFunc.
+func (f *FakeReference) GetTickerDetails(ctx context.Context, params *models.GetTickerDetailsParams, options ...models.RequestOption) (*models.GetTickerDetailsResponse, error) {
+	if f.GetTickerDetailsFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetTickerDetails")
+	}
+	return f.GetTickerDetailsFunc(ctx, params, options...)
+}
+
+// ListTickerNews calls ListTickerNewsFunc.
+func (f *FakeReference) ListTickerNews(ctx context.Context, params *models.ListTickerNewsParams, options ...models.RequestOption) *iter.Iter[models.TickerNews] {
+	if f.ListTickerNewsFunc == nil {
+		return Iter[models.TickerNews](nil, notImplemented("ReferenceAPI.ListTickerNews"))
+	}
+	return f.ListTickerNewsFunc(ctx, params, options...)
+}
+
+// GetTickerRelatedCompanies calls GetTickerRelatedCompaniesFunc.
+func (f *FakeReference) GetTickerRelatedCompanies(ctx context.Context, params *models.GetTickerRelatedCompaniesParams, options ...models.RequestOption) (*models.GetTickerRelatedCompaniesResponse, error) {
+	if f.GetTickerRelatedCompaniesFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetTickerRelatedCompanies")
+	}
+	return f.GetTickerRelatedCompaniesFunc(ctx, params, options...)
+}
+
+// GetTickerTypes calls GetTickerTypesFunc.
+func (f *FakeReference) GetTickerTypes(ctx context.Context, params *models.GetTickerTypesParams, options ...models.RequestOption) (*models.GetTickerTypesResponse, error) {
+	if f.GetTickerTypesFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetTickerTypes")
+	}
+	return f.GetTickerTypesFunc(ctx, params, options...)
+}
+
+// GetMarketHolidays calls GetMarketHolidaysFunc.
+func (f *FakeReference) GetMarketHolidays(ctx context.Context, options ...models.RequestOption) (*models.GetMarketHolidaysResponse, error) {
+	if f.GetMarketHolidaysFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetMarketHolidays")
+	}
+	return f.GetMarketHolidaysFunc(ctx, options...)
+}
+
+// GetMarketStatus calls GetMarketStatusFunc.
+func (f *FakeReference) GetMarketStatus(ctx context.Context, options ...models.RequestOption) (*models.GetMarketStatusResponse, error) {
+	if f.GetMarketStatusFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetMarketStatus")
+	}
+	return f.GetMarketStatusFunc(ctx, options...)
+}
+
+// ListSplits calls ListSplitsFunc.
+func (f *FakeReference) ListSplits(ctx context.Context, params *models.ListSplitsParams, options ...models.RequestOption) *iter.Iter[models.Split] {
+	if f.ListSplitsFunc == nil {
+		return Iter[models.Split](nil, notImplemented("ReferenceAPI.ListSplits"))
+	}
+	return f.ListSplitsFunc(ctx, params, options...)
+}
+
+// ListDividends calls ListDividendsFunc.
+func (f *FakeReference) ListDividends(ctx context.Context, params *models.ListDividendsParams, options ...models.RequestOption) *iter.Iter[models.Dividend] {
+	if f.ListDividendsFunc == nil {
+		return Iter[models.Dividend](nil, notImplemented("ReferenceAPI.ListDividends"))
+	}
+	return f.ListDividendsFunc(ctx, params, options...)
+}
+
+// ListConditions calls ListConditionsFunc.
+func (f *FakeReference) ListConditions(ctx context.Context, params *models.ListConditionsParams, options ...models.RequestOption) *iter.Iter[models.Condition] {
+	if f.ListConditionsFunc == nil {
+		return Iter[models.Condition](nil, notImplemented("ReferenceAPI.ListConditions"))
+	}
+	return f.ListConditionsFunc(ctx, params, options...)
+}
+
+// GetExchanges calls GetExchangesFunc.
+func (f *FakeReference) GetExchanges(ctx context.Context, params *models.GetExchangesParams, options ...models.RequestOption) (*models.GetExchangesResponse, error) {
+	if f.GetExchangesFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetExchanges")
+	}
+	return f.GetExchangesFunc(ctx, params, options...)
+}
+
+// GetOptionsContract calls GetOptionsContractFunc.
+func (f *FakeReference) GetOptionsContract(ctx context.Context, params *models.GetOptionsContractParams, options ...models.RequestOption) (*models.GetOptionsContractResponse, error) {
+	if f.GetOptionsContractFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetOptionsContract")
+	}
+	return f.GetOptionsContractFunc(ctx, params, options...)
+}
+
+// ListOptionsContracts calls ListOptionsContractsFunc.
+func (f *FakeReference) ListOptionsContracts(ctx context.Context, params *models.ListOptionsContractsParams, options ...models.RequestOption) *iter.Iter[models.OptionsContract] {
+	if f.ListOptionsContractsFunc == nil {
+		return Iter[models.OptionsContract](nil, notImplemented("ReferenceAPI.ListOptionsContracts"))
+	}
+	return f.ListOptionsContractsFunc(ctx, params, options...)
+}
+
+// ListShortInterest calls ListShortInterestFunc.
+func (f *FakeReference) ListShortInterest(ctx context.Context, params *models.ListShortInterestParams, options ...models.RequestOption) *iter.Iter[models.ShortInterest] {
+	if f.ListShortInterestFunc == nil {
+		return Iter[models.ShortInterest](nil, notImplemented("ReferenceAPI.ListShortInterest"))
+	}
+	return f.ListShortInterestFunc(ctx, params, options...)
+}
+
+// ListShortVolume calls ListShortVolumeFunc.
+func (f *FakeReference) ListShortVolume(ctx context.Context, params *models.ListShortVolumeParams, options ...models.RequestOption) *iter.Iter[models.ShortVolume] {
+	if f.ListShortVolumeFunc == nil {
+		return Iter[models.ShortVolume](nil, notImplemented("ReferenceAPI.ListShortVolume"))
+	}
+	return f.ListShortVolumeFunc(ctx, params, options...)
+}
+
+// ListTreasuryYields calls ListTreasuryYieldsFunc.
+func (f *FakeReference) ListTreasuryYields(ctx context.Context, params *models.ListTreasuryYieldsParams, options ...models.RequestOption) *iter.Iter[models.TreasuryYield] {
+	if f.ListTreasuryYieldsFunc == nil {
+		return Iter[models.TreasuryYield](nil, notImplemented("ReferenceAPI.ListTreasuryYields"))
+	}
+	return f.ListTreasuryYieldsFunc(ctx, params, options...)
+}
+
+// ListSECFilings calls ListSECFilingsFunc.
+func (f *FakeReference) ListSECFilings(ctx context.Context, params *models.ListSECFilingsParams, options ...models.RequestOption) *iter.Iter[models.SECFiling] {
+	if f.ListSECFilingsFunc == nil {
+		return Iter[models.SECFiling](nil, notImplemented("ReferenceAPI.ListSECFilings"))
+	}
+	return f.ListSECFilingsFunc(ctx, params, options...)
+}
+
+// GetSECFiling calls GetSECFilingFunc.
+func (f *FakeReference) GetSECFiling(ctx context.Context, params *models.GetSECFilingParams, options ...models.RequestOption) (*models.GetSECFilingResponse, error) {
+	if f.GetSECFilingFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetSECFiling")
+	}
+	return f.GetSECFilingFunc(ctx, params, options...)
+}
+
+// ListSECFilingFiles calls ListSECFilingFilesFunc.
+func (f *FakeReference) ListSECFilingFiles(ctx context.Context, params *models.ListSECFilingFilesParams, options ...models.RequestOption) *iter.Iter[models.SECFilingFile] {
+	if f.ListSECFilingFilesFunc == nil {
+		return Iter[models.SECFilingFile](nil, notImplemented("ReferenceAPI.ListSECFilingFiles"))
+	}
+	return f.ListSECFilingFilesFunc(ctx, params, options...)
+}
+
+// GetSECFilingFile calls GetSECFilingFileFunc.
+func (f *FakeReference) GetSECFilingFile(ctx context.Context, params *models.GetSECFilingFileParams, options ...models.RequestOption) (io.ReadCloser, error) {
+	if f.GetSECFilingFileFunc == nil {
+		return nil, notImplemented("ReferenceAPI.GetSECFilingFile")
+	}
+	return f.GetSECFilingFileFunc(ctx, params, options...)
+}
+
+// FakeTrades is a fake massive.TradesAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeTrades struct {
+	ListTradesFunc               func(ctx context.Context, params *models.ListTradesParams, options ...models.RequestOption) *iter.Iter[models.Trade]
+	ListTradesShardedFunc        func(ctx context.Context, params *models.ListTradesParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Trade]
+	GetLastTradeFunc             func(ctx context.Context, params *models.GetLastTradeParams, options ...models.RequestOption) (*models.GetLastTradeResponse, error)
+	GetLastCryptoTradeFunc       func(ctx context.Context, params *models.GetLastCryptoTradeParams, options ...models.RequestOption) (*models.GetLastCryptoTradeResponse, error)
+	ListHistoricTradesFunc       func(ctx context.Context, params *models.ListHistoricTradesParams, options ...models.RequestOption) *iter.Iter[models.LastTrade]
+	ListHistoricCryptoTradesFunc func(ctx context.Context, params *models.ListHistoricCryptoTradesParams, options ...models.RequestOption) *iter.Iter[models.CryptoTick]
+}
+
+// ListTrades calls ListTradesFunc.
+func (f *FakeTrades) ListTrades(ctx context.Context, params *models.ListTradesParams, options ...models.RequestOption) *iter.Iter[models.Trade] {
+	if f.ListTradesFunc == nil {
+		return Iter[models.Trade](nil, notImplemented("TradesAPI.ListTrades"))
+	}
+	return f.ListTradesFunc(ctx, params, options...)
+}
+
+// ListTradesSharded calls ListTradesShardedFunc.
+func (f *FakeTrades) ListTradesSharded(ctx context.Context, params *models.ListTradesParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Trade] {
+	if f.ListTradesShardedFunc == nil {
+		return Iter[models.Trade](nil, notImplemented("TradesAPI.ListTradesSharded"))
+	}
+	return f.ListTradesShardedFunc(ctx, params, shards, workers, options...)
+}
+
+// GetLastTrade calls GetLastTradeFunc.
+func (f *FakeTrades) GetLastTrade(ctx context.Context, params *models.GetLastTradeParams, options ...models.RequestOption) (*models.GetLastTradeResponse, error) {
+	if f.GetLastTradeFunc == nil {
+		return nil, notImplemented("TradesAPI.GetLastTrade")
+	}
+	return f.GetLastTradeFunc(ctx, params, options...)
+}
+
+// GetLastCryptoTrade calls GetLastCryptoTradeFunc.
+func (f *FakeTrades) GetLastCryptoTrade(ctx context.Context, params *models.GetLastCryptoTradeParams, options ...models.RequestOption) (*models.GetLastCryptoTradeResponse, error) {
+	if f.GetLastCryptoTradeFunc == nil {
+		return nil, notImplemented("TradesAPI.GetLastCryptoTrade")
+	}
+	return f.GetLastCryptoTradeFunc(ctx, params, options...)
+}
+
+// ListHistoricTrades calls ListHistoricTradesFunc.
+func (f *FakeTrades) ListHistoricTrades(ctx context.Context, params *models.ListHistoricTradesParams, options ...models.RequestOption) *iter.Iter[models.LastTrade] {
+	if f.ListHistoricTradesFunc == nil {
+		return Iter[models.LastTrade](nil, notImplemented("TradesAPI.ListHistoricTrades"))
+	}
+	return f.ListHistoricTradesFunc(ctx, params, options...)
+}
+
+// ListHistoricCryptoTrades calls ListHistoricCryptoTradesFunc.
+func (f *FakeTrades) ListHistoricCryptoTrades(ctx context.Context, params *models.ListHistoricCryptoTradesParams, options ...models.RequestOption) *iter.Iter[models.CryptoTick] {
+	if f.ListHistoricCryptoTradesFunc == nil {
+		return Iter[models.CryptoTick](nil, notImplemented("TradesAPI.ListHistoricCryptoTrades"))
+	}
+	return f.ListHistoricCryptoTradesFunc(ctx, params, options...)
+}
+
+// FakeSnapshot is a fake massive.SnapshotAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeSnapshot struct {
+	ListOptionsChainSnapshotFunc  func(ctx context.Context, params *models.ListOptionsChainParams, options ...models.RequestOption) *iter.Iter[models.OptionContractSnapshot]
+	GetAllTickersSnapshotFunc     func(ctx context.Context, params *models.GetAllTickersSnapshotParams, options ...models.RequestOption) (*models.GetAllTickersSnapshotResponse, error)
+	GetTickerSnapshotFunc         func(ctx context.Context, params *models.GetTickerSnapshotParams, options ...models.RequestOption) (*models.GetTickerSnapshotResponse, error)
+	GetGainersLosersSnapshotFunc  func(ctx context.Context, params *models.GetGainersLosersSnapshotParams, options ...models.RequestOption) (*models.GetGainersLosersSnapshotResponse, error)
+	GetOptionContractSnapshotFunc func(ctx context.Context, params *models.GetOptionContractSnapshotParams, options ...models.RequestOption) (*models.GetOptionContractSnapshotResponse, error)
+	GetCryptoFullBookSnapshotFunc func(ctx context.Context, params *models.GetCryptoFullBookSnapshotParams, options ...models.RequestOption) (*models.GetCryptoFullBookSnapshotResponse, error)
+	GetIndicesSnapshotFunc        func(ctx context.Context, params *models.GetIndicesSnapshotParams, options ...models.RequestOption) (*models.GetIndicesSnapshotResponse, error)
+	ListAssetSnapshotsFunc        func(ctx context.Context, params *models.ListAssetSnapshotsParams, options ...models.RequestOption) *iter.Iter[models.SnapshotResponseModel]
+	ListUniversalSnapshotsFunc    func(ctx context.Context, params *models.ListUniversalSnapshotsParams, options ...models.RequestOption) *iter.Iter[models.SnapshotResponseModel]
+}
+
+// ListOptionsChainSnapshot calls ListOptionsChainSnapshotFunc.
+func (f *FakeSnapshot) ListOptionsChainSnapshot(ctx context.Context, params *models.ListOptionsChainParams, options ...models.RequestOption) *iter.Iter[models.OptionContractSnapshot] {
+	if f.ListOptionsChainSnapshotFunc == nil {
+		return Iter[models.OptionContractSnapshot](nil, notImplemented("SnapshotAPI.ListOptionsChainSnapshot"))
+	}
+	return f.ListOptionsChainSnapshotFunc(ctx, params, options...)
+}
+
+// GetAllTickersSnapshot calls GetAllTickersSnapshotFunc.
+func (f *FakeSnapshot) GetAllTickersSnapshot(ctx context.Context, params *models.GetAllTickersSnapshotParams, options ...models.RequestOption) (*models.GetAllTickersSnapshotResponse, error) {
+	if f.GetAllTickersSnapshotFunc == nil {
+		return nil, notImplemented("SnapshotAPI.GetAllTickersSnapshot")
+	}
+	return f.GetAllTickersSnapshotFunc(ctx, params, options...)
+}
+
+// GetTickerSnapshot calls GetTickerSnapshotFunc.
+func (f *FakeSnapshot) GetTickerSnapshot(ctx context.Context, params *models.GetTickerSnapshotParams, options ...models.RequestOption) (*models.GetTickerSnapshotResponse, error) {
+	if f.GetTickerSnapshotFunc == nil {
+		return nil, notImplemented("SnapshotAPI.GetTickerSnapshot")
+	}
+	return f.GetTickerSnapshotFunc(ctx, params, options...)
+}
+
+// GetGainersLosersSnapshot calls GetGainersLosersSnapshotFunc.
+func (f *FakeSnapshot) GetGainersLosersSnapshot(ctx context.Context, params *models.GetGainersLosersSnapshotParams, options ...models.RequestOption) (*models.GetGainersLosersSnapshotResponse, error) {
+	if f.GetGainersLosersSnapshotFunc == nil {
+		return nil, notImplemented("SnapshotAPI.GetGainersLosersSnapshot")
+	}
+	return f.GetGainersLosersSnapshotFunc(ctx, params, options...)
+}
+
+// GetOptionContractSnapshot calls GetOptionContractSnapshotFunc.
+func (f *FakeSnapshot) GetOptionContractSnapshot(ctx context.Context, params *models.GetOptionContractSnapshotParams, options ...models.RequestOption) (*models.GetOptionContractSnapshotResponse, error) {
+	if f.GetOptionContractSnapshotFunc == nil {
+		return nil, notImplemented("SnapshotAPI.GetOptionContractSnapshot")
+	}
+	return f.GetOptionContractSnapshotFunc(ctx, params, options...)
+}
+
+// GetCryptoFullBookSnapshot calls GetCryptoFullBookSnapshotFunc.
+func (f *FakeSnapshot) GetCryptoFullBookSnapshot(ctx context.Context, params *models.GetCryptoFullBookSnapshotParams, options ...models.RequestOption) (*models.GetCryptoFullBookSnapshotResponse, error) {
+	if f.GetCryptoFullBookSnapshotFunc == nil {
+		return nil, notImplemented("SnapshotAPI.GetCryptoFullBookSnapshot")
+	}
+	return f.GetCryptoFullBookSnapshotFunc(ctx, params, options...)
+}
+
+// GetIndicesSnapshot calls GetIndicesSnapshotFunc.
+func (f *FakeSnapshot) GetIndicesSnapshot(ctx context.Context, params *models.GetIndicesSnapshotParams, options ...models.RequestOption) (*models.GetIndicesSnapshotResponse, error) {
+	if f.GetIndicesSnapshotFunc == nil {
+		return nil, notImplemented("SnapshotAPI.GetIndicesSnapshot")
+	}
+	return f.GetIndicesSnapshotFunc(ctx, params, options...)
+}
+
+// ListAssetSnapshots calls ListAssetSnapshotsFunc.
+func (f *FakeSnapshot) ListAssetSnapshots(ctx context.Context, params *models.ListAssetSnapshotsParams, options ...models.RequestOption) *iter.Iter[models.SnapshotResponseModel] {
+	if f.ListAssetSnapshotsFunc == nil {
+		return Iter[models.SnapshotResponseModel](nil, notImplemented("SnapshotAPI.ListAssetSnapshots"))
+	}
+	return f.ListAssetSnapshotsFunc(ctx, params, options...)
+}
+
+// ListUniversalSnapshots calls ListUniversalSnapshotsFunc.
+func (f *FakeSnapshot) ListUniversalSnapshots(ctx context.Context, params *models.ListUniversalSnapshotsParams, options ...models.RequestOption) *iter.Iter[models.SnapshotResponseModel] {
+	if f.ListUniversalSnapshotsFunc == nil {
+		return Iter[models.SnapshotResponseModel](nil, notImplemented("SnapshotAPI.ListUniversalSnapshots"))
+	}
+	return f.ListUniversalSnapshotsFunc(ctx, params, options...)
+}
+
+// FakeIndicators is a fake massive.IndicatorsAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeIndicators struct {
+	GetSMAFunc  func(ctx context.Context, params *models.GetSMAParams, options ...models.RequestOption) (*models.GetSMAResponse, error)
+	GetEMAFunc  func(ctx context.Context, params *models.GetEMAParams, options ...models.RequestOption) (*models.GetEMAResponse, error)
+	GetMACDFunc func(ctx context.Context, params *models.GetMACDParams, options ...models.RequestOption) (*models.GetMACDResponse, error)
+	GetRSIFunc  func(ctx context.Context, params *models.GetRSIParams, options ...models.RequestOption) (*models.GetRSIResponse, error)
+}
+
+// GetSMA calls GetSMAFunc.
+func (f *FakeIndicators) GetSMA(ctx context.Context, params *models.GetSMAParams, options ...models.RequestOption) (*models.GetSMAResponse, error) {
+	if f.GetSMAFunc == nil {
+		return nil, notImplemented("IndicatorsAPI.GetSMA")
+	}
+	return f.GetSMAFunc(ctx, params, options...)
+}
+
+// GetEMA calls GetEMAFunc.
+func (f *FakeIndicators) GetEMA(ctx context.Context, params *models.GetEMAParams, options ...models.RequestOption) (*models.GetEMAResponse, error) {
+	if f.GetEMAFunc == nil {
+		return nil, notImplemented("IndicatorsAPI.GetEMA")
+	}
+	return f.GetEMAFunc(ctx, params, options...)
+}
+
+// GetMACD calls GetMACDFunc.
+func (f *FakeIndicators) GetMACD(ctx context.Context, params *models.GetMACDParams, options ...models.RequestOption) (*models.GetMACDResponse, error) {
+	if f.GetMACDFunc == nil {
+		return nil, notImplemented("IndicatorsAPI.GetMACD")
+	}
+	return f.GetMACDFunc(ctx, params, options...)
+}
+
+// GetRSI calls GetRSIFunc.
+func (f *FakeIndicators) GetRSI(ctx context.Context, params *models.GetRSIParams, options ...models.RequestOption) (*models.GetRSIResponse, error) {
+	if f.GetRSIFunc == nil {
+		return nil, notImplemented("IndicatorsAPI.GetRSI")
+	}
+	return f.GetRSIFunc(ctx, params, options...)
+}
+
+// FakeSummaries is a fake massive.SummariesAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeSummaries struct {
+	GetSummariesFunc func(ctx context.Context, params *models.GetSummaryParams, options ...models.RequestOption) (*models.GetSummaryResponse, error)
+}
+
+// GetSummaries calls GetSummariesFunc.
+func (f *FakeSummaries) GetSummaries(ctx context.Context, params *models.GetSummaryParams, options ...models.RequestOption) (*models.GetSummaryResponse, error) {
+	if f.GetSummariesFunc == nil {
+		return nil, notImplemented("SummariesAPI.GetSummaries")
+	}
+	return f.GetSummariesFunc(ctx, params, options...)
+}
+
+// FakeFutures is a fake massive.FuturesAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeFutures struct {
+	ListFuturesAggsFunc             func(ctx context.Context, params *models.ListFuturesAggsParams, options ...models.RequestOption) *iter.Iter[models.FuturesAggregate]
+	ListFuturesContractsFunc        func(ctx context.Context, params *models.ListFuturesContractsParams, options ...models.RequestOption) *iter.Iter[models.FuturesContract]
+	GetFuturesContractFunc          func(ctx context.Context, params *models.GetFuturesContractParams, options ...models.RequestOption) (*models.GetFuturesContractResponse, error)
+	ListFuturesMarketStatusesFunc   func(ctx context.Context, params *models.ListFuturesMarketStatusesParams, options ...models.RequestOption) *iter.Iter[models.FuturesMarketStatus]
+	ListFuturesProductsFunc         func(ctx context.Context, params *models.ListFuturesProductsParams, options ...models.RequestOption) *iter.Iter[models.FuturesProduct]
+	GetFuturesProductFunc           func(ctx context.Context, params *models.GetFuturesProductParams, options ...models.RequestOption) (*models.GetFuturesProductResponse, error)
+	ListFuturesSchedulesFunc        func(ctx context.Context, params *models.ListFuturesSchedulesParams, options ...models.RequestOption) *iter.Iter[models.FuturesSchedule]
+	ListFuturesProductSchedulesFunc func(ctx context.Context, params *models.ListFuturesProductSchedulesParams, options ...models.RequestOption) *iter.Iter[models.FuturesSchedule]
+	ListFuturesTradesFunc           func(ctx context.Context, params *models.ListFuturesTradesParams, options ...models.RequestOption) *iter.Iter[models.FuturesTrade]
+	ListFuturesQuotesFunc           func(ctx context.Context, params *models.ListFuturesQuotesParams, options ...models.RequestOption) *iter.Iter[models.FuturesQuote]
+}
+
+// ListFuturesAggs calls ListFuturesAggsFunc.
+func (f *FakeFutures) ListFuturesAggs(ctx context.Context, params *models.ListFuturesAggsParams, options ...models.RequestOption) *iter.Iter[models.FuturesAggregate] {
+	if f.ListFuturesAggsFunc == nil {
+		return Iter[models.FuturesAggregate](nil, notImplemented("FuturesAPI.ListFuturesAggs"))
+	}
+	return f.ListFuturesAggsFunc(ctx, params, options...)
+}
+
+// ListFuturesContracts calls ListFuturesContractsFunc.
+func (f *FakeFutures) ListFuturesContracts(ctx context.Context, params *models.ListFuturesContractsParams, options ...models.RequestOption) *iter.Iter[models.FuturesContract] {
+	if f.ListFuturesContractsFunc == nil {
+		return Iter[models.FuturesContract](nil, notImplemented("FuturesAPI.ListFuturesContracts"))
+	}
+	return f.ListFuturesContractsFunc(ctx, params, options...)
+}
+
+// GetFuturesContract calls GetFuturesContractFunc.
+func (f *FakeFutures) GetFuturesContract(ctx context.Context, params *models.GetFuturesContractParams, options ...models.RequestOption) (*models.GetFuturesContractResponse, error) {
+	if f.GetFuturesContractFunc == nil {
+		return nil, notImplemented("FuturesAPI.GetFuturesContract")
+	}
+	return f.GetFuturesContractFunc(ctx, params, options...)
+}
+
+// ListFuturesMarketStatuses calls ListFuturesMarketStatusesFunc.
+func (f *FakeFutures) ListFuturesMarketStatuses(ctx context.Context, params *models.ListFuturesMarketStatusesParams, options ...models.RequestOption) *iter.Iter[models.FuturesMarketStatus] {
+	if f.ListFuturesMarketStatusesFunc == nil {
+		return Iter[models.FuturesMarketStatus](nil, notImplemented("FuturesAPI.ListFuturesMarketStatuses"))
+	}
+	return f.ListFuturesMarketStatusesFunc(ctx, params, options...)
+}
+
+// ListFuturesProducts calls ListFuturesProductsFunc.
+func (f *FakeFutures) ListFuturesProducts(ctx context.Context, params *models.ListFuturesProductsParams, options ...models.RequestOption) *iter.Iter[models.FuturesProduct] {
+	if f.ListFuturesProductsFunc == nil {
+		return Iter[models.FuturesProduct](nil, notImplemented("FuturesAPI.ListFuturesProducts"))
+	}
+	return f.ListFuturesProductsFunc(ctx, params, options...)
+}
+
+// GetFuturesProduct calls GetFuturesProductFunc.
+func (f *FakeFutures) GetFuturesProduct(ctx context.Context, params *models.GetFuturesProductParams, options ...models.RequestOption) (*models.GetFuturesProductResponse, error) {
+	if f.GetFuturesProductFunc == nil {
+		return nil, notImplemented("FuturesAPI.GetFuturesProduct")
+	}
+	return f.GetFuturesProductFunc(ctx, params, options...)
+}
+
+// ListFuturesSchedules calls ListFuturesSchedulesFunc.
+func (f *FakeFutures) ListFuturesSchedules(ctx context.Context, params *models.ListFuturesSchedulesParams, options ...models.RequestOption) *iter.Iter[models.FuturesSchedule] {
+	if f.ListFuturesSchedulesFunc == nil {
+		return Iter[models.FuturesSchedule](nil, notImplemented("FuturesAPI.ListFuturesSchedules"))
+	}
+	return f.ListFuturesSchedulesFunc(ctx, params, options...)
+}
+
+// ListFuturesProductSchedules calls ListFuturesProductSchedulesFunc.
+func (f *FakeFutures) ListFuturesProductSchedules(ctx context.Context, params *models.ListFuturesProductSchedulesParams, options ...models.RequestOption) *iter.Iter[models.FuturesSchedule] {
+	if f.ListFuturesProductSchedulesFunc == nil {
+		return Iter[models.FuturesSchedule](nil, notImplemented("FuturesAPI.ListFuturesProductSchedules"))
+	}
+	return f.ListFuturesProductSchedulesFunc(ctx, params, options...)
+}
+
+// ListFuturesTrades calls ListFuturesTradesFunc.
+func (f *FakeFutures) ListFuturesTrades(ctx context.Context, params *models.ListFuturesTradesParams, options ...models.RequestOption) *iter.Iter[models.FuturesTrade] {
+	if f.ListFuturesTradesFunc == nil {
+		return Iter[models.FuturesTrade](nil, notImplemented("FuturesAPI.ListFuturesTrades"))
+	}
+	return f.ListFuturesTradesFunc(ctx, params, options...)
+}
+
+// ListFuturesQuotes calls ListFuturesQuotesFunc.
+func (f *FakeFutures) ListFuturesQuotes(ctx context.Context, params *models.ListFuturesQuotesParams, options ...models.RequestOption) *iter.Iter[models.FuturesQuote] {
+	if f.ListFuturesQuotesFunc == nil {
+		return Iter[models.FuturesQuote](nil, notImplemented("FuturesAPI.ListFuturesQuotes"))
+	}
+	return f.ListFuturesQuotesFunc(ctx, params, options...)
+}
+
+// FakeVX is a fake massive.VXAPI. Its methods call the function fields of the same name with a Func
+// suffix and return ErrNotImplemented if they're nil.
+type FakeVX struct {
+	ListStockFinancialsFunc  func(ctx context.Context, params *models.ListStockFinancialsParams, options ...models.RequestOption) *iter.Iter[models.StockFinancial]
+	GetTickerEventsFunc      func(ctx context.Context, params *models.GetTickerEventsParams, options ...models.RequestOption) (*models.GetTickerEventsResponse, error)
+	ListIPOsFunc             func(ctx context.Context, params *models.ListIPOsParams, options ...models.RequestOption) *iter.Iter[models.IPOResult]
+	ListTickerTaxonomiesFunc func(ctx context.Context, params *models.ListTickerTaxonomiesParams, options ...models.RequestOption) *iter.Iter[models.TickerTaxonomy]
+	GetTaxonomyIndexFunc     func(ctx context.Context, params *models.ListTickerTaxonomiesParams, options ...models.RequestOption) (*models.TaxonomyIndex, error)
+}
+
+// ListStockFinancials calls ListStockFinancialsFunc.
+func (f *FakeVX) ListStockFinancials(ctx context.Context, params *models.ListStockFinancialsParams, options ...models.RequestOption) *iter.Iter[models.StockFinancial] {
+	if f.ListStockFinancialsFunc == nil {
+		return Iter[models.StockFinancial](nil, notImplemented("VXAPI.ListStockFinancials"))
+	}
+	return f.ListStockFinancialsFunc(ctx, params, options...)
+}
+
+// GetTickerEvents calls GetTickerEventsFunc.
+func (f *FakeVX) GetTickerEvents(ctx context.Context, params *models.GetTickerEventsParams, options ...models.RequestOption) (*models.GetTickerEventsResponse, error) {
+	if f.GetTickerEventsFunc == nil {
+		return nil, notImplemented("VXAPI.GetTickerEvents")
+	}
+	return f.GetTickerEventsFunc(ctx, params, options...)
+}
+
+// ListIPOs calls ListIPOsFunc.
+func (f *FakeVX) ListIPOs(ctx context.Context, params *models.ListIPOsParams, options ...models.RequestOption) *iter.Iter[models.IPOResult] {
+	if f.ListIPOsFunc == nil {
+		return Iter[models.IPOResult](nil, notImplemented("VXAPI.ListIPOs"))
+	}
+	return f.ListIPOsFunc(ctx, params, options...)
+}
+
+// ListTickerTaxonomies calls ListTickerTaxonomiesFunc.
+func (f *FakeVX) ListTickerTaxonomies(ctx context.Context, params *models.ListTickerTaxonomiesParams, options ...models.RequestOption) *iter.Iter[models.TickerTaxonomy] {
+	if f.ListTickerTaxonomiesFunc == nil {
+		return Iter[models.TickerTaxonomy](nil, notImplemented("VXAPI.ListTickerTaxonomies"))
+	}
+	return f.ListTickerTaxonomiesFunc(ctx, params, options...)
+}
+
+// GetTaxonomyIndex calls GetTaxonomyIndexFunc.
+func (f *FakeVX) GetTaxonomyIndex(ctx context.Context, params *models.ListTickerTaxonomiesParams, options ...models.RequestOption) (*models.TaxonomyIndex, error) {
+	if f.GetTaxonomyIndexFunc == nil {
+		return nil, notImplemented("VXAPI.GetTaxonomyIndex")
+	}
+	return f.GetTaxonomyIndexFunc(ctx, params, options...)
+}
+
+// FakeClient is a fake massive.API that embeds the fakes of the sub-client interfaces. The experimental
+// methods are faked by the VX field.
+type FakeClient struct {
+	FakeAggs
+	FakeQuotes
+	FakeReference
+	FakeTrades
+	FakeSnapshot
+	FakeIndicators
+	FakeSummaries
+	FakeFutures
+	VX FakeVX
+}
+
+var (
+	_ massive.API           = (*FakeClient)(nil)
+	_ massive.AggsAPI       = (*FakeAggs)(nil)
+	_ massive.QuotesAPI     = (*FakeQuotes)(nil)
+	_ massive.ReferenceAPI  = (*FakeReference)(nil)
+	_ massive.TradesAPI     = (*FakeTrades)(nil)
+	_ massive.SnapshotAPI   = (*FakeSnapshot)(nil)
+	_ massive.IndicatorsAPI = (*FakeIndicators)(nil)
+	_ massive.SummariesAPI  = (*FakeSummaries)(nil)
+	_ massive.FuturesAPI    = (*FakeFutures)(nil)
+	_ massive.VXAPI         = (*FakeVX)(nil)
+)
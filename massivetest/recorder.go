@@ -0,0 +1,264 @@
+package massivetest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// ErrUnmatched is returned by a Recorder in replay mode for requests that aren't in its cassette.
+var ErrUnmatched = errors.New("massivetest: no recorded response for request")
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay serves responses from an existing cassette without sending requests.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and saves the responses to a new cassette when the recorder is closed.
+	ModeRecord
+)
+
+// Cassette is a set of recorded interactions, stored as a JSON file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response. Requests are identified by their method and normalized URI,
+// which is the path and sorted query without the host and the API key.
+type Interaction struct {
+	Method   string           `json:"method"`
+	URI      string           `json:"uri"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedResponse is a recorded HTTP response. JSON bodies are stored as is, other bodies as strings.
+type RecordedResponse struct {
+	StatusCode int             `json:"status_code"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+}
+
+// RecorderOption changes the configuration of a Recorder.
+type RecorderOption func(r *Recorder)
+
+// WithTransport sets the transport that sends requests in record mode. By default, http.DefaultTransport is used.
+func WithTransport(rt http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithTest makes the recorder fail the test for every unmatched request in replay mode, in addition to returning
+// ErrUnmatched, so that misses aren't hidden by retries or error handling in the code under test.
+func WithTest(tb testing.TB) RecorderOption {
+	return func(r *Recorder) {
+		r.tb = tb
+	}
+}
+
+// Recorder is an http.RoundTripper that records API responses to a cassette file and replays them, e.g. to run
+// tests against real responses without network access. Use it as the transport of the HTTP client passed to
+// massive.NewWithClient:
+//
+//	rec, err := massivetest.NewRecorder("testdata/trades.json", massivetest.ModeReplay)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer rec.Close()
+//	c := massive.NewWithClient(apiKey, rec.HTTPClient())
+//
+// Repeated requests are replayed in the order they were recorded and the last response is replayed once they're
+// used up.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	tb        testing.TB
+
+	mu       sync.Mutex
+	cassette Cassette
+	played   map[string]int
+}
+
+// NewRecorder returns a recorder for the cassette at path. In replay mode, the cassette must exist.
+func NewRecorder(path string, mode Mode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		played:    make(map[string]int),
+	}
+	for _, o := range opts {
+		o(r)
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+		}
+	}
+
+	return r, nil
+}
+
+// HTTPClient returns an HTTP client that uses the recorder as its transport.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	uri := normalizeURI(req.URL)
+	if r.mode == ModeReplay {
+		return r.replay(req, uri)
+	}
+	return r.record(req, uri)
+}
+
+// Close saves the cassette in record mode. It does nothing in replay mode.
+func (r *Recorder) Close() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+func (r *Recorder) replay(req *http.Request, uri string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := req.Method + " " + uri
+	var matches []*Interaction
+	for i := range r.cassette.Interactions {
+		in := &r.cassette.Interactions[i]
+		if in.Method == req.Method && in.URI == uri {
+			matches = append(matches, in)
+		}
+	}
+	if len(matches) == 0 {
+		err := fmt.Errorf("%w: %s in cassette %s", ErrUnmatched, key, r.path)
+		if r.tb != nil {
+			r.tb.Error(err)
+		}
+		return nil, err
+	}
+
+	n := min(r.played[key], len(matches)-1)
+	r.played[key]++
+	return matches[n].Response.response(req)
+}
+
+func (r *Recorder) record(req *http.Request, uri string) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := readBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	header := res.Header.Clone()
+	header.Del("Content-Encoding")
+	header.Del("Content-Length")
+	recorded := RecordedResponse{StatusCode: res.StatusCode, Header: header}
+	if len(body) > 0 {
+		if json.Valid(body) {
+			recorded.Body = body
+		} else if recorded.Body, err = json.Marshal(string(body)); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{Method: req.Method, URI: uri, Response: recorded})
+	r.mu.Unlock()
+
+	return recorded.response(req)
+}
+
+// response returns the recorded response as an HTTP response to a request.
+func (rr RecordedResponse) response(req *http.Request) (*http.Response, error) {
+	body := []byte(rr.Body)
+	if len(body) > 0 && body[0] == '"' {
+		var s string
+		if err := json.Unmarshal(body, &s); err != nil {
+			return nil, err
+		}
+		body = []byte(s)
+	}
+
+	header := rr.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rr.StatusCode, http.StatusText(rr.StatusCode)),
+		StatusCode:    rr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// readBody reads a response body, decompressing it if it's gzipped.
+func readBody(res *http.Response) ([]byte, error) {
+	if !strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		return io.ReadAll(res.Body)
+	}
+
+	zr, err := gzip.NewReader(res.Body)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
+
+// normalizeURI returns the path and sorted query of a URL without the API key, so that recordings don't depend
+// on the host or contain credentials.
+func normalizeURI(u *url.URL) string {
+	query := u.Query()
+	query.Del("apiKey")
+
+	uri := u.EscapedPath()
+	if q := query.Encode(); q != "" {
+		uri += "?" + q
+	}
+	return uri
+}
@@ -0,0 +1,59 @@
+package massivetest_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/massive-com/client-go/v2/massivetest"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "testdata", "trades.json")
+	srv := massivetest.NewServer(massivetest.WithAPIKey("secret"))
+	srv.SetResults(massive.ListTradesPath, []models.Trade{{ID: "1"}, {ID: "2"}, {ID: "3"}})
+
+	listTrades := func(c *massive.Client) ([]string, error) {
+		iter := c.ListTrades(context.Background(), models.ListTradesParams{Ticker: "AAPL"}.WithLimit(2))
+		var ids []string
+		for iter.Next() {
+			ids = append(ids, iter.Item().ID)
+		}
+		return ids, iter.Err()
+	}
+
+	// record against the server
+	rec, err := massivetest.NewRecorder(cassette, massivetest.ModeRecord)
+	assert.Nil(t, err)
+	ids, err := listTrades(massive.NewWithClient("secret", rec.HTTPClient(), client.WithBaseURL(srv.URL)))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Nil(t, rec.Close())
+	srv.Close()
+
+	data, err := os.ReadFile(cassette)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "secret")
+
+	// replay without the server and with another API key
+	rec, err = massivetest.NewRecorder(cassette, massivetest.ModeReplay)
+	assert.Nil(t, err)
+	c := massive.NewWithClient("other", rec.HTTPClient(), client.WithBaseURL("http://localhost:1"))
+	ids, err = listTrades(c)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+
+	_, err = c.GetLastTrade(context.Background(), &models.GetLastTradeParams{Ticker: "AAPL"})
+	assert.True(t, errors.Is(err, massivetest.ErrUnmatched))
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	_, err := massivetest.NewRecorder(filepath.Join(t.TempDir(), "missing.json"), massivetest.ModeReplay)
+	assert.NotNil(t, err)
+}
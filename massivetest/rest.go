@@ -0,0 +1,351 @@
+// Package massivetest provides fake Massive servers for testing code built on the REST and WebSocket clients
+// without network access.
+//
+// NewServer starts an HTTP server that serves the REST endpoints of this module from fixture data, with
+// pagination, error injection and latency:
+//
+//	srv := massivetest.NewServer()
+//	defer srv.Close()
+//	srv.SetResults(massive.ListTradesPath, []models.Trade{{Price: 171.55}, {Price: 171.56}})
+//	srv.InjectError(massive.GetLastTradePath, http.StatusTooManyRequests, 1)
+//
+//	c := srv.Client()
+//
+// NewWebSocketServer starts a server that speaks the auth, subscribe and status protocol of the WebSocket client
+// and publishes events to subscribed connections.
+package massivetest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/client"
+)
+
+// DefaultPageSize is the number of results per page of list endpoints if the request doesn't set a limit.
+const DefaultPageSize = 10
+
+//go:embed fixtures/*.json
+var fixtureFiles embed.FS
+
+// Fixture is the data that the server returns for an endpoint.
+type Fixture struct {
+	// Path is either a path template of the rest package (e.g. massive.ListTradesPath) or a concrete path (e.g.
+	// "/v3/trades/AAPL"). When several fixtures match a request, the one with the most literal segments wins.
+	Path string `json:"path"`
+
+	// Results are the results of a list endpoint. They're returned in pages that link to each other via next_url.
+	Results []json.RawMessage `json:"results,omitempty"`
+
+	// Response is the complete response body of an endpoint that isn't paginated.
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+// DefaultFixtures returns sample data for the aggs, trades, quotes, reference, snapshot, futures and vX endpoints.
+// Servers are created with these fixtures.
+func DefaultFixtures() []Fixture {
+	var fixtures []Fixture
+	files, _ := fixtureFiles.ReadDir("fixtures")
+	for _, f := range files {
+		data, err := fixtureFiles.ReadFile("fixtures/" + f.Name())
+		if err != nil {
+			panic(err)
+		}
+		var fs []Fixture
+		if err := json.Unmarshal(data, &fs); err != nil {
+			panic(fmt.Sprintf("invalid fixture file %s: %v", f.Name(), err))
+		}
+		fixtures = append(fixtures, fs...)
+	}
+	return fixtures
+}
+
+// Option changes the configuration of a Server.
+type Option func(s *Server)
+
+// WithAPIKey makes the server reject requests that don't authenticate with the API key. By default, any API key
+// is accepted.
+func WithAPIKey(key string) Option {
+	return func(s *Server) {
+		s.apiKey = key
+	}
+}
+
+// WithPageSize sets the number of results per page of list endpoints for requests that don't set a limit.
+func WithPageSize(n int) Option {
+	return func(s *Server) {
+		s.pageSize = n
+	}
+}
+
+// WithLatency delays every response by the given duration.
+func WithLatency(d time.Duration) Option {
+	return func(s *Server) {
+		s.latency = d
+	}
+}
+
+// WithFixtures adds fixtures to the server, replacing default fixtures with the same path.
+func WithFixtures(fixtures ...Fixture) Option {
+	return func(s *Server) {
+		for _, f := range fixtures {
+			s.fixtures[f.Path] = f
+		}
+	}
+}
+
+// Server is a fake Massive REST API server.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	apiKey   string
+	pageSize int
+	latency  time.Duration
+	fixtures map[string]Fixture
+	errors   map[string]*injectedError
+	requests []*http.Request
+	nextID   int
+}
+
+type injectedError struct {
+	status int
+	times  int
+}
+
+// NewServer starts a server that serves the default fixtures. The caller should call Close when finished to shut
+// it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		pageSize: DefaultPageSize,
+		fixtures: make(map[string]Fixture),
+		errors:   make(map[string]*injectedError),
+	}
+	for _, f := range DefaultFixtures() {
+		s.fixtures[f.Path] = f
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a REST client that sends requests to the server.
+func (s *Server) Client(opts ...client.Option) *massive.Client {
+	apiKey := s.apiKey
+	if apiKey == "" {
+		apiKey = "API_KEY"
+	}
+	return massive.NewWithOptions(apiKey, append([]client.Option{client.WithBaseURL(s.URL)}, opts...)...)
+}
+
+// SetResults sets the results of a list endpoint. The results must be a slice of models (e.g. []models.Trade).
+func (s *Server) SetResults(path string, results any) {
+	data, err := json.Marshal(results)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal results: %v", err))
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		panic(fmt.Sprintf("results must be a slice: %v", err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[path] = Fixture{Path: path, Results: raw}
+}
+
+// SetResponse sets the complete response of an endpoint that isn't paginated (e.g. a models.GetLastTradeResponse).
+func (s *Server) SetResponse(path string, response any) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal response: %v", err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[path] = Fixture{Path: path, Response: data}
+}
+
+// InjectError makes the next requests to an endpoint fail with the status code. The path is matched like the
+// path of a fixture. A negative number of times makes every request fail until ClearErrors is called.
+func (s *Server) InjectError(path string, status, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[path] = &injectedError{status: status, times: times}
+}
+
+// ClearErrors removes all injected errors.
+func (s *Server) ClearErrors() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors = make(map[string]*injectedError)
+}
+
+// SetLatency delays every following response by the given duration.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// Requests returns the requests that the server received, in order.
+func (s *Server) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Clone(r.Context()))
+	s.nextID++
+	requestID := fmt.Sprintf("massivetest-%d", s.nextID)
+	latency := s.latency
+	fixture, found := s.match(r.URL.Path)
+	injected := s.injectedError(r.URL.Path)
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", requestID)
+
+	if s.apiKey != "" && !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, requestID, "Unknown API Key")
+		return
+	}
+	if injected != 0 {
+		writeError(w, injected, requestID, "injected error")
+		return
+	}
+	if !found {
+		writeError(w, http.StatusNotFound, requestID, fmt.Sprintf("no fixture for path %s", r.URL.Path))
+		return
+	}
+
+	if fixture.Results == nil {
+		_, _ = w.Write(fixture.Response)
+		return
+	}
+	s.writePage(w, r, fixture, requestID)
+}
+
+// writePage writes the page of list results selected by the request's cursor and limit.
+func (s *Server) writePage(w http.ResponseWriter, r *http.Request, fixture Fixture, requestID string) {
+	query := r.URL.Query()
+	offset, _ := strconv.Atoi(query.Get("cursor"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit <= 0 {
+		limit = s.pageSize
+	}
+
+	offset = min(max(offset, 0), len(fixture.Results))
+	end := min(offset+limit, len(fixture.Results))
+	res := map[string]any{
+		"status":     "OK",
+		"request_id": requestID,
+		"count":      end - offset,
+		"results":    fixture.Results[offset:end],
+	}
+	if end < len(fixture.Results) {
+		res["next_url"] = fmt.Sprintf("%s%s?cursor=%d&limit=%d", s.URL, r.URL.Path, end, limit)
+	}
+
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// authorized reports whether the request authenticates with the server's API key.
+func (s *Server) authorized(r *http.Request) bool {
+	return r.Header.Get("Authorization") == "Bearer "+s.apiKey || r.URL.Query().Get("apiKey") == s.apiKey
+}
+
+// match returns the fixture for a request path.
+func (s *Server) match(path string) (Fixture, bool) {
+	pattern, ok := bestMatch(path, s.fixtures)
+	return s.fixtures[pattern], ok
+}
+
+// injectedError returns the status code of an injected error for a request path or zero if there's none.
+func (s *Server) injectedError(path string) int {
+	pattern, ok := bestMatch(path, s.errors)
+	if !ok {
+		return 0
+	}
+
+	e := s.errors[pattern]
+	if e.times > 0 {
+		e.times--
+		if e.times == 0 {
+			delete(s.errors, pattern)
+		}
+	}
+	return e.status
+}
+
+// bestMatch returns the pattern that matches the path with the most literal segments.
+func bestMatch[V any](path string, patterns map[string]V) (string, bool) {
+	keys := make([]string, 0, len(patterns))
+	for k := range patterns {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	best, bestScore := "", -1
+	for _, pattern := range keys {
+		if score, ok := matchPath(pattern, path); ok && score > bestScore {
+			best, bestScore = pattern, score
+		}
+	}
+	return best, bestScore >= 0
+}
+
+// matchPath reports whether a path matches a pattern, where segments like "{ticker}" match any segment, and
+// returns the number of literal segments that matched.
+func matchPath(pattern, path string) (int, bool) {
+	ps, segs := strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(strings.Trim(path, "/"), "/")
+	if len(ps) != len(segs) {
+		return 0, false
+	}
+
+	score := 0
+	for i, p := range ps {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			if segs[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if p != segs[i] {
+			return 0, false
+		}
+		score++
+	}
+	return score, true
+}
+
+func writeError(w http.ResponseWriter, status int, requestID, message string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"status":     "ERROR",
+		"request_id": requestID,
+		"error":      message,
+	})
+}
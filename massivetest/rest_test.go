@@ -0,0 +1,90 @@
+package massivetest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/massive-com/client-go/v2/massivetest"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerPagination(t *testing.T) {
+	srv := massivetest.NewServer()
+	defer srv.Close()
+
+	trades := []models.Trade{{ID: "1", Price: 1}, {ID: "2", Price: 2}, {ID: "3", Price: 3}, {ID: "4", Price: 4}, {ID: "5", Price: 5}}
+	srv.SetResults(massive.ListTradesPath, trades)
+
+	c := srv.Client()
+	iter := c.ListTrades(context.Background(), models.ListTradesParams{Ticker: "AAPL"}.WithLimit(2))
+
+	var ids []string
+	for iter.Next() {
+		ids = append(ids, iter.Item().ID)
+	}
+	assert.Nil(t, iter.Err())
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	assert.Equal(t, 3, iter.PageCount())
+	assert.Len(t, srv.Requests(), 3)
+}
+
+func TestServerDefaultFixtures(t *testing.T) {
+	srv := massivetest.NewServer()
+	defer srv.Close()
+	c := srv.Client()
+
+	details, err := c.GetTickerDetails(context.Background(), &models.GetTickerDetailsParams{Ticker: "AAPL"})
+	assert.Nil(t, err)
+	assert.Equal(t, "AAPL", details.Results.Ticker)
+
+	last, err := c.GetLastTrade(context.Background(), &models.GetLastTradeParams{Ticker: "AAPL"})
+	assert.Nil(t, err)
+	assert.Equal(t, 171.55, last.Results.Price)
+
+	iter := c.ListQuotes(context.Background(), &models.ListQuotesParams{Ticker: "AAPL"})
+	assert.True(t, iter.Next())
+	assert.Nil(t, iter.Err())
+}
+
+func TestServerInjectError(t *testing.T) {
+	srv := massivetest.NewServer()
+	defer srv.Close()
+	c := srv.Client()
+
+	srv.InjectError(massive.GetLastTradePath, http.StatusNotFound, 1)
+	params := &models.GetLastTradeParams{Ticker: "AAPL"}
+
+	_, err := c.GetLastTrade(context.Background(), params)
+	assert.True(t, errors.Is(err, models.ErrNotFound))
+
+	_, err = c.GetLastTrade(context.Background(), params)
+	assert.Nil(t, err)
+}
+
+func TestServerAPIKey(t *testing.T) {
+	srv := massivetest.NewServer(massivetest.WithAPIKey("secret"))
+	defer srv.Close()
+
+	_, err := srv.Client().GetLastTrade(context.Background(), &models.GetLastTradeParams{Ticker: "AAPL"})
+	assert.Nil(t, err)
+
+	c := massive.NewWithOptions("wrong", client.WithBaseURL(srv.URL))
+	_, err = c.GetLastTrade(context.Background(), &models.GetLastTradeParams{Ticker: "AAPL"})
+	assert.True(t, errors.Is(err, models.ErrUnauthorized))
+}
+
+func TestServerLatency(t *testing.T) {
+	srv := massivetest.NewServer(massivetest.WithLatency(50 * time.Millisecond))
+	defer srv.Close()
+
+	start := time.Now()
+	_, err := srv.Client().GetLastTrade(context.Background(), &models.GetLastTradeParams{Ticker: "AAPL"})
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
@@ -0,0 +1,252 @@
+package massivetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	massivews "github.com/massive-com/client-go/v2/websocket"
+	"github.com/massive-com/client-go/v2/websocket/models"
+)
+
+// WebSocketServer is a fake Massive WebSocket API server. It authenticates connections, tracks their
+// subscriptions and publishes events to the connections that are subscribed to them.
+type WebSocketServer struct {
+	*httptest.Server
+
+	apiKey   string
+	upgrader websocket.Upgrader
+
+	mu    sync.Mutex
+	conns map[*wsConn]struct{}
+}
+
+// wsConn is a connection to a WebSocket client.
+type wsConn struct {
+	mu            sync.Mutex
+	conn          *websocket.Conn
+	authenticated bool
+	subs          map[string]struct{}
+}
+
+// NewWebSocketServer starts a WebSocket server. If apiKey is empty, any API key is accepted. The caller should
+// call Close when finished to shut it down.
+func NewWebSocketServer(apiKey string) *WebSocketServer {
+	s := &WebSocketServer{
+		apiKey: apiKey,
+		conns:  make(map[*wsConn]struct{}),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveWS))
+	return s
+}
+
+// Feed returns the feed to configure a WebSocket client with to connect to the server.
+func (s *WebSocketServer) Feed() massivews.Feed {
+	return massivews.Feed("ws" + strings.TrimPrefix(s.URL, "http"))
+}
+
+// Publish sends events (e.g. models.EquityTrade) to every authenticated connection that's subscribed to them.
+// An event matches a subscription like "T.AAPL" by its event type and symbol, and "T.*" matches any symbol.
+func (s *WebSocketServer) Publish(events ...any) error {
+	type target struct {
+		conn *wsConn
+		msgs []json.RawMessage
+	}
+
+	s.mu.Lock()
+	conns := make([]*wsConn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+
+	targets := make([]target, len(conns))
+	for i, c := range conns {
+		targets[i].conn = c
+	}
+	for _, ev := range events {
+		msg, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		for i := range targets {
+			if targets[i].conn.subscribed(msg) {
+				targets[i].msgs = append(targets[i].msgs, msg)
+			}
+		}
+	}
+
+	for _, t := range targets {
+		if len(t.msgs) > 0 {
+			if err := t.conn.send(t.msgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Subscriptions returns the subscriptions (e.g. "T.AAPL") of all connections, sorted and without duplicates.
+func (s *WebSocketServer) Subscriptions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]struct{})
+	for c := range s.conns {
+		c.mu.Lock()
+		for sub := range c.subs {
+			seen[sub] = struct{}{}
+		}
+		c.mu.Unlock()
+	}
+
+	subs := make([]string, 0, len(seen))
+	for sub := range seen {
+		subs = append(subs, sub)
+	}
+	sort.Strings(subs)
+	return subs
+}
+
+// Disconnect closes every open connection, e.g. to test that clients reconnect and resubscribe.
+func (s *WebSocketServer) Disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for c := range s.conns {
+		_ = c.conn.Close()
+		delete(s.conns, c)
+	}
+}
+
+func (s *WebSocketServer) serveWS(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	c := &wsConn{conn: conn, subs: make(map[string]struct{})}
+	s.mu.Lock()
+	s.conns[c] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	if err := c.sendStatus("connected", "Connected Successfully"); err != nil {
+		return
+	}
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var msg models.ControlMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			_ = c.sendStatus("error", "invalid message")
+			continue
+		}
+		if err := s.handle(c, msg); err != nil {
+			return
+		}
+	}
+}
+
+// handle responds to a control message from a client.
+func (s *WebSocketServer) handle(c *wsConn, msg models.ControlMessage) error {
+	switch msg.Action {
+	case models.Auth:
+		if s.apiKey != "" && msg.Params != s.apiKey {
+			return c.sendStatus("auth_failed", "authentication failed")
+		}
+		c.mu.Lock()
+		c.authenticated = true
+		c.mu.Unlock()
+		return c.sendStatus("auth_success", "authenticated")
+	case models.Subscribe, models.Unsubscribe:
+		c.mu.Lock()
+		authenticated := c.authenticated
+		c.mu.Unlock()
+		if !authenticated {
+			return c.sendStatus("error", "not authorized")
+		}
+
+		for _, param := range strings.Split(msg.Params, ",") {
+			c.mu.Lock()
+			if msg.Action == models.Subscribe {
+				c.subs[param] = struct{}{}
+			} else {
+				delete(c.subs, param)
+			}
+			c.mu.Unlock()
+
+			status := "subscribed to: " + param
+			if msg.Action == models.Unsubscribe {
+				status = "unsubscribed to: " + param
+			}
+			if err := c.sendStatus("success", status); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return c.sendStatus("error", "unknown action: "+string(msg.Action))
+	}
+}
+
+// subscribed reports whether the connection is subscribed to an event.
+func (c *wsConn) subscribed(msg json.RawMessage) bool {
+	var ev struct {
+		EventType string `json:"ev"`
+		Symbol    string `json:"sym"`
+		Pair      string `json:"pair"`
+	}
+	if err := json.Unmarshal(msg, &ev); err != nil {
+		return false
+	}
+	symbol := ev.Symbol
+	if symbol == "" {
+		symbol = ev.Pair
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.authenticated {
+		return false
+	}
+	_, all := c.subs[ev.EventType+".*"]
+	_, one := c.subs[ev.EventType+"."+symbol]
+	return all || one
+}
+
+func (c *wsConn) sendStatus(status, message string) error {
+	msg, err := json.Marshal(models.ControlMessage{
+		EventType: models.EventType{EventType: "status"},
+		Status:    status,
+		Message:   message,
+	})
+	if err != nil {
+		return err
+	}
+	return c.send([]json.RawMessage{msg})
+}
+
+func (c *wsConn) send(msgs []json.RawMessage) error {
+	data, err := json.Marshal(msgs)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteMessage(websocket.TextMessage, data)
+}
@@ -0,0 +1,68 @@
+package massivetest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/massive-com/client-go/v2/massivetest"
+	massivews "github.com/massive-com/client-go/v2/websocket"
+	"github.com/massive-com/client-go/v2/websocket/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebSocketServer(t *testing.T) {
+	srv := massivetest.NewWebSocketServer("secret")
+	defer srv.Close()
+
+	c, err := massivews.New(massivews.Config{
+		APIKey: "secret",
+		Feed:   srv.Feed(),
+		Market: massivews.Stocks,
+	})
+	assert.Nil(t, err)
+	defer c.Close()
+
+	assert.Nil(t, c.Subscribe(massivews.StocksTrades, "AAPL"))
+	assert.Nil(t, c.Connect())
+	assert.Eventually(t, func() bool {
+		return len(srv.Subscriptions()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"T.AAPL"}, srv.Subscriptions())
+
+	err = srv.Publish(
+		models.EquityTrade{EventType: models.EventType{EventType: "T"}, Symbol: "MSFT", Price: 420.1},
+		models.EquityTrade{EventType: models.EventType{EventType: "T"}, Symbol: "AAPL", Price: 171.55},
+	)
+	assert.Nil(t, err)
+
+	select {
+	case out := <-c.Output():
+		trade, ok := out.(models.EquityTrade)
+		assert.True(t, ok)
+		assert.Equal(t, "AAPL", trade.Symbol)
+		assert.Equal(t, 171.55, trade.Price)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for trade")
+	}
+}
+
+func TestWebSocketServerAuthFailed(t *testing.T) {
+	srv := massivetest.NewWebSocketServer("secret")
+	defer srv.Close()
+
+	c, err := massivews.New(massivews.Config{
+		APIKey: "wrong",
+		Feed:   srv.Feed(),
+		Market: massivews.Stocks,
+	})
+	assert.Nil(t, err)
+	defer c.Close()
+
+	assert.Nil(t, c.Connect())
+	select {
+	case err := <-c.Error():
+		assert.NotNil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for auth error")
+	}
+}
@@ -0,0 +1,64 @@
+// Package massiveotel provides OpenTelemetry instrumentation for the Massive REST and WebSocket clients.
+//
+// REST calls are traced by adding the client middleware returned by Middleware:
+//
+//	c := massive.New(apiKey, client.WithMiddleware(massiveotel.Middleware()))
+//
+// WebSocket clients report metrics through the value returned by NewWebSocketMetrics:
+//
+//	metrics, err := massiveotel.NewWebSocketMetrics()
+//	if err != nil {
+//		return err
+//	}
+//	c, err := massivews.New(massivews.Config{APIKey: apiKey, Feed: massivews.RealTime, Market: massivews.Stocks, Metrics: metrics})
+//
+// Both use the global tracer and meter providers unless others are passed as options, so they're no-ops until
+// an OpenTelemetry SDK is configured.
+package massiveotel
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name used for all tracers and meters created by this package.
+const ScopeName = "github.com/massive-com/client-go/v2/otel"
+
+// Options are used to configure the instrumentation.
+type Options struct {
+	// TracerProvider creates the tracer for REST spans. Omitting this uses the global provider.
+	TracerProvider trace.TracerProvider
+
+	// MeterProvider creates the meter for WebSocket metrics. Omitting this uses the global provider.
+	MeterProvider metric.MeterProvider
+}
+
+// Option changes the configuration of Options.
+type Option func(o *Options)
+
+// WithTracerProvider sets a tracer provider as an option.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *Options) {
+		o.TracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets a meter provider as an option.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(o *Options) {
+		o.MeterProvider = mp
+	}
+}
+
+func mergeOptions(opts ...Option) *Options {
+	options := &Options{
+		TracerProvider: otel.GetTracerProvider(),
+		MeterProvider:  otel.GetMeterProvider(),
+	}
+	for _, o := range opts {
+		o(options)
+	}
+
+	return options
+}
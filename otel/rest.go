@@ -0,0 +1,67 @@
+package massiveotel
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/massive-com/client-go/v2/rest/client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attribute keys that are specific to the Massive API.
+const (
+	EndpointKey  = attribute.Key("massive.endpoint")
+	RequestIDKey = attribute.Key("massive.request_id")
+	AttemptsKey  = attribute.Key("massive.attempts")
+)
+
+// Middleware returns REST client middleware that records a client span for every API call, including each page
+// fetched by a list iterator. Spans are named after the endpoint's path template (e.g. "GET /v3/trades/{ticker}").
+func Middleware(opts ...Option) client.Middleware {
+	options := mergeOptions(opts...)
+	tracer := options.TracerProvider.Tracer(ScopeName)
+
+	return func(next client.RoundTrip) client.RoundTrip {
+		return func(ctx context.Context, req *client.Request) (*client.Response, error) {
+			endpoint := endpoint(req)
+			ctx, span := tracer.Start(ctx, req.Method+" "+endpoint,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					EndpointKey.String(endpoint),
+				))
+			defer span.End()
+
+			res, err := next(ctx, req)
+			if res != nil {
+				span.SetAttributes(
+					semconv.URLFull(res.URL),
+					semconv.HTTPResponseStatusCode(res.StatusCode),
+					semconv.HTTPResponseBodySize(int(res.Size)),
+					RequestIDKey.String(res.RequestID),
+					AttemptsKey.Int(res.Attempts),
+				)
+			}
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return res, err
+		}
+	}
+}
+
+// endpoint returns the path template of a request, falling back to its URI path for calls made via CallURL.
+func endpoint(req *client.Request) string {
+	if req.Path != "" {
+		return req.Path
+	}
+	if u, err := url.Parse(req.URI); err == nil {
+		return u.Path
+	}
+	return req.URI
+}
@@ -0,0 +1,59 @@
+package massiveotel_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	massiveotel "github.com/massive-com/client-go/v2/otel"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	c := massive.New("API_KEY", client.WithMiddleware(massiveotel.Middleware(massiveotel.WithTracerProvider(tp))))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.massive.com/v3/trades/AAPL",
+		jsonResponder(200, `{"status":"OK","request_id":"req1","next_url":"https://api.massive.com/v3/trades/AAPL?cursor=NEXT","results":[{"id":"1"}]}`).
+			HeaderAdd(http.Header{"X-Request-ID": []string{"req1"}}))
+	httpmock.RegisterResponder("GET", "https://api.massive.com/v3/trades/AAPL?cursor=NEXT",
+		jsonResponder(404, `{"status":"NOT_FOUND","request_id":"req2","error":"not found"}`))
+
+	iter := c.ListTrades(context.Background(), &models.ListTradesParams{Ticker: "AAPL"})
+	for iter.Next() {
+	}
+	assert.NotNil(t, iter.Err())
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		for _, span := range spans {
+			assert.Equal(t, "GET /v3/trades/{ticker}", span.Name())
+			assert.Contains(t, span.Attributes(), massiveotel.EndpointKey.String(massive.ListTradesPath))
+		}
+
+		assert.Contains(t, spans[0].Attributes(), attribute.Int("http.response.status_code", 200))
+		assert.Contains(t, spans[0].Attributes(), massiveotel.RequestIDKey.String("req1"))
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+		assert.Contains(t, spans[1].Attributes(), attribute.Int("http.response.status_code", 404))
+		assert.Contains(t, spans[1].Attributes(), massiveotel.RequestIDKey.String("req2"))
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	}
+}
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return httpmock.NewStringResponder(status, body).
+		HeaderAdd(http.Header{"Content-Type": []string{"application/json"}})
+}
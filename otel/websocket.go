@@ -0,0 +1,92 @@
+package massiveotel
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	massivews "github.com/massive-com/client-go/v2/websocket"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Attribute keys used by the WebSocket metrics.
+const (
+	EventTypeKey = attribute.Key("massive.event_type")
+	QueueKey     = attribute.Key("massive.queue")
+	ResultKey    = attribute.Key("massive.result")
+)
+
+type wsMetrics struct {
+	messages     metric.Int64Counter
+	reconnects   metric.Int64Counter
+	parseFailure metric.Int64Counter
+
+	readQueue atomic.Int64
+	output    atomic.Int64
+}
+
+// NewWebSocketMetrics returns a massivews.Metrics implementation that records the following instruments:
+//
+//   - massive.websocket.messages: messages received, by event type
+//   - massive.websocket.queue.depth: messages waiting in the read queue and output channel
+//   - massive.websocket.reconnects: reconnect attempts, by result
+//   - massive.websocket.parse_failures: messages that couldn't be decoded
+func NewWebSocketMetrics(opts ...Option) (massivews.Metrics, error) {
+	options := mergeOptions(opts...)
+	meter := options.MeterProvider.Meter(ScopeName)
+
+	m := &wsMetrics{}
+	var err error
+	if m.messages, err = meter.Int64Counter("massive.websocket.messages",
+		metric.WithDescription("Number of messages received by the WebSocket client."),
+		metric.WithUnit("{message}")); err != nil {
+		return nil, fmt.Errorf("failed to create messages counter: %w", err)
+	}
+	if m.reconnects, err = meter.Int64Counter("massive.websocket.reconnects",
+		metric.WithDescription("Number of reconnect attempts made by the WebSocket client."),
+		metric.WithUnit("{attempt}")); err != nil {
+		return nil, fmt.Errorf("failed to create reconnects counter: %w", err)
+	}
+	if m.parseFailure, err = meter.Int64Counter("massive.websocket.parse_failures",
+		metric.WithDescription("Number of messages the WebSocket client failed to decode."),
+		metric.WithUnit("{message}")); err != nil {
+		return nil, fmt.Errorf("failed to create parse failures counter: %w", err)
+	}
+
+	readQueue := metric.WithAttributeSet(attribute.NewSet(QueueKey.String("read")))
+	output := metric.WithAttributeSet(attribute.NewSet(QueueKey.String("output")))
+	if _, err = meter.Int64ObservableGauge("massive.websocket.queue.depth",
+		metric.WithDescription("Number of entries waiting in the WebSocket client's queues."),
+		metric.WithUnit("{entry}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(m.readQueue.Load(), readQueue)
+			o.Observe(m.output.Load(), output)
+			return nil
+		})); err != nil {
+		return nil, fmt.Errorf("failed to create queue depth gauge: %w", err)
+	}
+
+	return m, nil
+}
+
+func (m *wsMetrics) MessageReceived(eventType string) {
+	m.messages.Add(context.Background(), 1, metric.WithAttributes(EventTypeKey.String(eventType)))
+}
+
+func (m *wsMetrics) QueueDepth(readQueue, output int) {
+	m.readQueue.Store(int64(readQueue))
+	m.output.Store(int64(output))
+}
+
+func (m *wsMetrics) Reconnected(err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	m.reconnects.Add(context.Background(), 1, metric.WithAttributes(ResultKey.String(result)))
+}
+
+func (m *wsMetrics) ParseFailed(error) {
+	m.parseFailure.Add(context.Background(), 1)
+}
@@ -0,0 +1,60 @@
+package massiveotel_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	massiveotel "github.com/massive-com/client-go/v2/otel"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestWebSocketMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	m, err := massiveotel.NewWebSocketMetrics(massiveotel.WithMeterProvider(mp))
+	assert.Nil(t, err)
+
+	m.MessageReceived("T")
+	m.MessageReceived("T")
+	m.MessageReceived("Q")
+	m.QueueDepth(3, 42)
+	m.Reconnected(errors.New("dial failed"))
+	m.Reconnected(nil)
+	m.ParseFailed(errors.New("bad json"))
+
+	var rm metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(context.Background(), &rm))
+	got := map[string]map[attribute.Distinct]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, metric := range sm.Metrics {
+			got[metric.Name] = map[attribute.Distinct]int64{}
+			switch data := metric.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					got[metric.Name][dp.Attributes.Equivalent()] = dp.Value
+				}
+			case metricdata.Gauge[int64]:
+				for _, dp := range data.DataPoints {
+					got[metric.Name][dp.Attributes.Equivalent()] = dp.Value
+				}
+			}
+		}
+	}
+
+	key := func(kvs ...attribute.KeyValue) attribute.Distinct {
+		set := attribute.NewSet(kvs...)
+		return set.Equivalent()
+	}
+	assert.Equal(t, int64(2), got["massive.websocket.messages"][key(massiveotel.EventTypeKey.String("T"))])
+	assert.Equal(t, int64(1), got["massive.websocket.messages"][key(massiveotel.EventTypeKey.String("Q"))])
+	assert.Equal(t, int64(3), got["massive.websocket.queue.depth"][key(massiveotel.QueueKey.String("read"))])
+	assert.Equal(t, int64(42), got["massive.websocket.queue.depth"][key(massiveotel.QueueKey.String("output"))])
+	assert.Equal(t, int64(1), got["massive.websocket.reconnects"][key(massiveotel.ResultKey.String("success"))])
+	assert.Equal(t, int64(1), got["massive.websocket.reconnects"][key(massiveotel.ResultKey.String("failure"))])
+	assert.Equal(t, int64(1), got["massive.websocket.parse_failures"][key()])
+}
@@ -15,6 +15,8 @@ const (
 	GetGroupedDailyAggsPath  = "/v2/aggs/grouped/locale/{locale}/market/{marketType}/{date}"
 	GetDailyOpenCloseAggPath = "/v1/open-close/{ticker}/{date}"
 	GetPreviousCloseAggPath  = "/v2/aggs/ticker/{ticker}/prev"
+
+	GetCryptoDailyOpenClosePath = "/v1/open-close/crypto/{from}/{to}/{date}"
 )
 
 // AggsClient defines a REST client for the Massive aggs API.
@@ -36,11 +38,21 @@ type AggsClient struct {
 //		return iter.Err()
 //	}
 func (ac *AggsClient) ListAggs(ctx context.Context, params *models.ListAggsParams, options ...models.RequestOption) *iter.Iter[models.Agg] {
-	return iter.NewIter(ctx, ListAggsPath, params, func(uri string) (iter.ListResponse, []models.Agg, error) {
+	return iter.NewIter(ctx, ListAggsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.Agg, error) {
+		res := &models.ListAggsResponse{}
+		err := ac.CallPage(ctx, http.MethodGet, ListAggsPath, uri, res, options...)
+		return res, res.Results, err
+	}, options...)
+}
+
+// ListAggsSharded is like ListAggs but splits the From/To window of the params into shards that are fetched
+// concurrently (see iter.NewShardedIter).
+func (ac *AggsClient) ListAggsSharded(ctx context.Context, params *models.ListAggsParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Agg] {
+	return iter.NewShardedIter(ctx, ListAggsPath, params, shards, workers, func(ctx context.Context, uri string) (iter.ListResponse, []models.Agg, error) {
 		res := &models.ListAggsResponse{}
-		err := ac.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := ac.CallPage(ctx, http.MethodGet, ListAggsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetAggs retrieves aggregate bars for a specified ticker over a given date range in custom time window sizes.
@@ -71,6 +83,14 @@ func (ac *AggsClient) GetDailyOpenCloseAgg(ctx context.Context, params *models.G
 	return res, err
 }
 
+// GetCryptoDailyOpenClose retrieves the open and close prices of a crypto pair on a certain date, along with the
+// opening and closing trades of each exchange.
+func (ac *AggsClient) GetCryptoDailyOpenClose(ctx context.Context, params *models.GetCryptoDailyOpenCloseParams, opts ...models.RequestOption) (*models.GetCryptoDailyOpenCloseResponse, error) {
+	res := &models.GetCryptoDailyOpenCloseResponse{}
+	err := ac.Call(ctx, http.MethodGet, GetCryptoDailyOpenClosePath, params, res, opts...)
+	return res, err
+}
+
 // GetPreviousCloseAgg retrieves the previous day's open, high, low, and close (OHLC) for the specified ticker.
 // For more details see https://massive.com/docs/stocks/get_v2_aggs_ticker__stocksticker__prev.
 func (ac *AggsClient) GetPreviousCloseAgg(ctx context.Context, params *models.GetPreviousCloseAggParams, opts ...models.RequestOption) (*models.GetPreviousCloseAggResponse, error) {
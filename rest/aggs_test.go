@@ -235,6 +235,45 @@ func TestGetDailyOpenCloseAgg(t *testing.T) {
 	assert.Equal(t, &expect, res)
 }
 
+func TestGetCryptoDailyOpenClose(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	expectedResponse := `{
+	"close": 11050.64,
+	"closingTrades": [
+		{"c": [2], "i": "973323250", "p": 11050.64, "s": 0.006128, "t": 1602287999795, "x": 4},
+		{"c": [1], "i": "105717893", "p": 11049.4, "s": 0.014, "t": 1602287999659, "x": 17}
+	],
+	"day": "2020-10-09T00:00:00.000Z",
+	"isUTC": true,
+	"open": 10932.44,
+	"openTrades": [
+		{"c": [2], "i": "511235746", "p": 10932.44, "s": 0.002, "t": 1602201600056, "x": 1},
+		{"c": [2], "i": "511235751", "p": 10923.76, "s": 0.02, "t": 1602201600141, "x": 4}
+	],
+	"symbol": "BTC-USD"
+}`
+
+	registerResponder("https://api.massive.com/v1/open-close/crypto/BTC/USD/2020-10-09?adjusted=true", expectedResponse)
+	res, err := c.GetCryptoDailyOpenClose(context.Background(), models.GetCryptoDailyOpenCloseParams{
+		From: "BTC",
+		To:   "USD",
+		Date: models.Date(time.Date(2020, 10, 9, 0, 0, 0, 0, time.Local)),
+	}.WithAdjusted(true))
+	assert.Nil(t, err)
+
+	var expect models.GetCryptoDailyOpenCloseResponse
+	err = json.Unmarshal([]byte(expectedResponse), &expect)
+	assert.Nil(t, err)
+	assert.Equal(t, &expect, res)
+	assert.True(t, res.IsUTC)
+	assert.Equal(t, "511235746", res.OpenTrades[0].ID)
+	assert.Equal(t, 17, res.ClosingTrades[1].Exchange)
+}
+
 func TestGetPreviousCloseAgg(t *testing.T) {
 	c := massive.New("API_KEY")
 
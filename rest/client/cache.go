@@ -0,0 +1,291 @@
+package client
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// DefaultCacheSize is the number of responses kept by the default in-memory cache.
+const DefaultCacheSize = 1000
+
+// DefaultCacheTTL is how long cached responses are considered fresh unless the server says otherwise.
+const DefaultCacheTTL = 5 * time.Minute
+
+// DefaultCachePaths are the path templates of the reference endpoints whose responses rarely change (ticker
+// details, ticker types, market holidays, conditions and exchanges). They're cached unless a CacheConfig sets Paths
+// or AllPaths.
+var DefaultCachePaths = []string{
+	"/v3/reference/tickers/{ticker}",
+	"/v3/reference/tickers/types",
+	"/v1/marketstatus/upcoming",
+	"/v3/reference/conditions",
+	"/v3/reference/exchanges",
+}
+
+// CacheEntry is a cached API response.
+type CacheEntry struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Header contains the response headers.
+	Header http.Header
+
+	// Body is the raw (uncompressed) response body.
+	Body []byte
+
+	// Expires is the time after which the entry must be revalidated with the server.
+	Expires time.Time
+}
+
+// ETag returns the entity tag of the cached response, if it has one.
+func (e *CacheEntry) ETag() string {
+	return e.Header.Get("ETag")
+}
+
+// Cache stores API responses. Implementations must be safe for concurrent use. Entries past their expiry are
+// still useful to revalidate a response using its ETag, so implementations should keep them until evicted.
+type Cache interface {
+	// Get returns the entry for a key if there is one.
+	Get(key string) (*CacheEntry, bool)
+
+	// Set stores an entry for a key.
+	Set(key string, entry *CacheEntry)
+}
+
+// CacheConfig configures response caching.
+type CacheConfig struct {
+	// Cache stores the responses. Omitting this uses an in-memory LRU cache of DefaultCacheSize entries. Clients
+	// with different API keys can share a cache since their responses are cached separately.
+	Cache Cache
+
+	// TTL is how long responses stay fresh if the server doesn't send a Cache-Control max-age. Omitting this
+	// defaults to DefaultCacheTTL.
+	TTL time.Duration
+
+	// Paths restricts caching to endpoints with these path templates (e.g. "/v3/reference/exchanges").
+	// Omitting this caches the endpoints of DefaultCachePaths.
+	Paths []string
+
+	// AllPaths caches every GET request regardless of Paths. Most endpoints serve market data that changes
+	// constantly, so it should be combined with a TTL that's short enough for the data.
+	AllPaths bool
+}
+
+// responseCache applies a CacheConfig to API calls.
+type responseCache struct {
+	cache Cache
+	ttl   time.Duration
+	paths map[string]bool
+}
+
+func newResponseCache(config CacheConfig) *responseCache {
+	rc := &responseCache{
+		cache: config.Cache,
+		ttl:   config.TTL,
+	}
+	if rc.cache == nil {
+		rc.cache = NewLRUCache(DefaultCacheSize)
+	}
+	if rc.ttl <= 0 {
+		rc.ttl = DefaultCacheTTL
+	}
+	if !config.AllPaths {
+		paths := config.Paths
+		if len(paths) == 0 {
+			paths = DefaultCachePaths
+		}
+		rc.paths = make(map[string]bool, len(paths))
+		for _, p := range paths {
+			rc.paths[p] = true
+		}
+	}
+
+	return rc
+}
+
+// key returns the cache key of a request or an empty string if the request can't be cached. Responses are cached
+// per API key and headers since they may depend on the key's entitlements or on the user that a request is made for
+// (e.g. the Launchpad edge headers), but they're hashed so that they aren't stored in the cache.
+func (rc *responseCache) key(r *Request, options *models.RequestOptions, token string) string {
+	if r.Method != http.MethodGet {
+		return ""
+	}
+
+	if rc.paths != nil {
+		path := r.Path
+		if path == "" {
+			if u, err := url.Parse(r.URI); err == nil {
+				path = u.Path
+			}
+		}
+		if !rc.paths[path] {
+			return ""
+		}
+	}
+
+	if options.APIKey != nil {
+		token = *options.APIKey
+	}
+	h := sha256.New()
+	h.Write([]byte(token))
+	h.Write([]byte("\n"))
+	_ = options.Headers.Write(h)
+
+	key := r.URI
+	if len(options.QueryParams) > 0 {
+		key += "#" + options.QueryParams.Encode()
+	}
+	return key + "@" + hex.EncodeToString(h.Sum(nil))
+}
+
+// entry builds a cache entry for a response or returns nil if the response must not be stored.
+func (rc *responseCache) entry(res *http.Response, body []byte, now time.Time) *CacheEntry {
+	ttl, ok := rc.freshness(res.Header)
+	if !ok {
+		return nil
+	}
+
+	return &CacheEntry{
+		StatusCode: res.StatusCode,
+		Header:     res.Header.Clone(),
+		Body:       body,
+		Expires:    now.Add(ttl),
+	}
+}
+
+// freshness returns how long a response stays fresh based on its Cache-Control header, or false if it must
+// not be stored at all.
+func (rc *responseCache) freshness(header http.Header) (time.Duration, bool) {
+	ttl := rc.ttl
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store":
+			return 0, false
+		case "no-cache":
+			ttl = 0
+		case "max-age":
+			if secs, err := strconv.Atoi(value); err == nil && ttl > 0 {
+				ttl = time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	return ttl, true
+}
+
+// store caches a successful response. If the server confirmed that a stale cached response is still valid, it
+// refreshes the cached entry instead and decodes its body into the result.
+func (rc *responseCache) store(key string, r *Request, res *resty.Response, meta *Response, cached *CacheEntry) error {
+	now := time.Now()
+	if res.StatusCode() == http.StatusNotModified && cached != nil {
+		if ttl, ok := rc.freshness(res.Header()); ok {
+			refreshed := *cached
+			refreshed.Expires = now.Add(ttl)
+			rc.cache.Set(key, &refreshed)
+		}
+		meta.FromCache = true
+		return decodeBody(cached.Body, r.Result, meta)
+	}
+
+	if res.IsSuccess() {
+		if entry := rc.entry(res.RawResponse, res.Body(), now); entry != nil {
+			rc.cache.Set(key, entry)
+		}
+	}
+
+	return nil
+}
+
+// cachedResponse describes a response served from the cache without contacting the server.
+func (c *Client) cachedResponse(r *Request, entry *CacheEntry) *Response {
+	uri := r.URI
+	if strings.HasPrefix(uri, "/") {
+		uri = c.HTTP.BaseURL + uri
+	}
+
+	return &Response{
+		StatusCode: entry.StatusCode,
+		Header:     entry.Header.Clone(),
+		URL:        redactURI(uri),
+		RequestID:  entry.Header.Get("X-Request-ID"),
+		Size:       int64(len(entry.Body)),
+		FromCache:  true,
+	}
+}
+
+// LRUCache is an in-memory Cache that evicts the least recently used entries once it's full.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache returns an in-memory cache that holds up to size entries.
+func NewLRUCache(size int) *LRUCache {
+	if size < 1 {
+		size = 1
+	}
+
+	return &LRUCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the entry for a key if there is one.
+func (c *LRUCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+// Set stores an entry for a key, evicting the least recently used entry if the cache is full.
+func (c *LRUCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruItem{key: key, entry: entry})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
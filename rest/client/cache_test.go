@@ -0,0 +1,242 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// cacheHits returns middleware that records whether each response came from the cache.
+func cacheHits(hits *[]bool) client.Middleware {
+	return func(next client.RoundTrip) client.RoundTrip {
+		return func(ctx context.Context, req *client.Request) (*client.Response, error) {
+			res, err := next(ctx, req)
+			if res != nil {
+				*hits = append(*hits, res.FromCache)
+			}
+			return res, err
+		}
+	}
+}
+
+func TestCache(t *testing.T) {
+	var hits []bool
+	c := client.New("API_KEY", client.WithCache(client.CacheConfig{AllPaths: true}), client.WithMiddleware(cacheHits(&hits)))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, jsonResponder(200, `{"status":"OK","request_id":"req1"}`))
+
+	for i := 0; i < 3; i++ {
+		res := &models.BaseResponse{}
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", res)
+		assert.Nil(t, err)
+		assert.Equal(t, "req1", res.RequestID)
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.Equal(t, []bool{false, true, true}, hits)
+
+	// bypassing the cache fetches a fresh response
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{}, models.BypassCache())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	// other query params are cached separately
+	err = c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{}, models.QueryParam("a", "b"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestCacheSharedByAPIKeys(t *testing.T) {
+	cache := client.CacheConfig{Cache: client.NewLRUCache(client.DefaultCacheSize), AllPaths: true}
+	c1 := client.New("KEY1", client.WithCache(cache))
+	c2 := client.New("KEY2", client.WithCache(cache))
+
+	httpmock.ActivateNonDefault(c1.HTTP.GetClient())
+	httpmock.ActivateNonDefault(c2.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, func(req *http.Request) (*http.Response, error) {
+		res := httpmock.NewStringResponse(200, `{"status":"OK","request_id":"`+req.Header.Get("Authorization")+`"}`)
+		res.Header.Set("Content-Type", "application/json")
+		return res, nil
+	})
+
+	call := func(c client.Client, opts ...models.RequestOption) string {
+		res := &models.BaseResponse{}
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", res, opts...)
+		assert.Nil(t, err)
+		return res.RequestID
+	}
+
+	assert.Equal(t, "Bearer KEY1", call(c1))
+	assert.Equal(t, "Bearer KEY2", call(c2))
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	// each client gets its own cached response, as do calls made with another API key
+	assert.Equal(t, "Bearer KEY1", call(c1))
+	assert.Equal(t, "Bearer KEY2", call(c2))
+	assert.Equal(t, "Bearer KEY1", call(c2, models.APIKey("KEY1")))
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	assert.Equal(t, 2, cache.Cache.(*client.LRUCache).Len())
+}
+
+func TestCacheSkipsErrorsAndOtherMethods(t *testing.T) {
+	c := client.New("API_KEY", client.WithCache(client.CacheConfig{AllPaths: true}), client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 1}))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, jsonResponder(404, `{"status":"NOT_FOUND"}`))
+	httpmock.RegisterResponder("POST", resourceURL, jsonResponder(200, `{"status":"OK"}`))
+
+	for i := 0; i < 2; i++ {
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+		assert.ErrorIs(t, err, models.ErrNotFound)
+		err = c.CallURL(context.Background(), http.MethodPost, "/v1/resource", &models.BaseResponse{})
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+func TestCachePaths(t *testing.T) {
+	c := client.New("API_KEY", client.WithCache(client.CacheConfig{Paths: []string{"/v1/resource/{id}"}}))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL+"/1", jsonResponder(200, `{"status":"OK"}`))
+	httpmock.RegisterResponder("GET", "https://api.massive.com/v1/other", jsonResponder(200, `{"status":"OK"}`))
+
+	params := &struct {
+		ID string `path:"id"`
+	}{ID: "1"}
+	for i := 0; i < 2; i++ {
+		err := c.Call(context.Background(), http.MethodGet, "/v1/resource/{id}", params, &models.BaseResponse{})
+		assert.Nil(t, err)
+		err = c.CallURL(context.Background(), http.MethodGet, "/v1/other", &models.BaseResponse{})
+		assert.Nil(t, err)
+	}
+
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["GET "+resourceURL+"/1"])
+	assert.Equal(t, 2, info["GET https://api.massive.com/v1/other"])
+}
+
+func TestCacheDefaultPaths(t *testing.T) {
+	c := client.New("API_KEY", client.WithCache(client.CacheConfig{}))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://api.massive.com/v3/reference/exchanges", jsonResponder(200, `{"status":"OK"}`))
+	httpmock.RegisterResponder("GET", "https://api.massive.com/v2/last/trade/AAPL", jsonResponder(200, `{"status":"OK"}`))
+
+	params := &struct {
+		Ticker string `path:"ticker"`
+	}{Ticker: "AAPL"}
+	for i := 0; i < 2; i++ {
+		err := c.Call(context.Background(), http.MethodGet, "/v3/reference/exchanges", &struct{}{}, &models.BaseResponse{})
+		assert.Nil(t, err)
+		err = c.Call(context.Background(), http.MethodGet, "/v2/last/trade/{ticker}", params, &models.BaseResponse{})
+		assert.Nil(t, err)
+	}
+
+	// only the reference endpoint is cached
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["GET https://api.massive.com/v3/reference/exchanges"])
+	assert.Equal(t, 2, info["GET https://api.massive.com/v2/last/trade/AAPL"])
+}
+
+func TestCacheHeaders(t *testing.T) {
+	c := client.New("API_KEY", client.WithCache(client.CacheConfig{AllPaths: true}))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, func(req *http.Request) (*http.Response, error) {
+		res := httpmock.NewStringResponse(200, `{"status":"OK","request_id":"`+req.Header.Get(models.HeaderEdgeID)+`"}`)
+		res.Header.Set("Content-Type", "application/json")
+		return res, nil
+	})
+
+	call := func(edgeID, edgeIP string) string {
+		res := &models.BaseResponse{}
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", res, models.RequiredEdgeHeaders(edgeID, edgeIP))
+		assert.Nil(t, err)
+		return res.RequestID
+	}
+
+	// calls made for different edge users aren't served each other's responses
+	assert.Equal(t, "user1", call("user1", "192.0.2.1"))
+	assert.Equal(t, "user2", call("user2", "192.0.2.2"))
+	assert.Equal(t, "user1", call("user1", "192.0.2.1"))
+	assert.Equal(t, "user2", call("user2", "192.0.2.2"))
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestCacheControl(t *testing.T) {
+	c := client.New("API_KEY", client.WithCache(client.CacheConfig{AllPaths: true}))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, jsonResponder(200, `{"status":"OK"}`).
+		HeaderAdd(http.Header{"Cache-Control": []string{"no-store"}}))
+
+	for i := 0; i < 2; i++ {
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestCacheRevalidation(t *testing.T) {
+	var hits []bool
+	c := client.New("API_KEY", client.WithCache(client.CacheConfig{AllPaths: true}), client.WithMiddleware(cacheHits(&hits)))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	var etags []string
+	httpmock.RegisterResponder("GET", resourceURL, func(req *http.Request) (*http.Response, error) {
+		etags = append(etags, req.Header.Get("If-None-Match"))
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			return httpmock.NewStringResponse(http.StatusNotModified, ""), nil
+		}
+		res := httpmock.NewStringResponse(200, `{"status":"OK","request_id":"req1"}`)
+		res.Header.Set("Content-Type", "application/json")
+		res.Header.Set("Cache-Control", "max-age=0")
+		res.Header.Set("ETag", `"v1"`)
+		return res, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		res := &models.BaseResponse{}
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", res)
+		assert.Nil(t, err)
+		assert.Equal(t, "req1", res.RequestID)
+	}
+
+	assert.Equal(t, []string{"", `"v1"`}, etags)
+	assert.Equal(t, []bool{false, true}, hits)
+}
+
+func TestLRUCache(t *testing.T) {
+	c := client.NewLRUCache(2)
+	c.Set("a", &client.CacheEntry{Body: []byte("a")})
+	c.Set("b", &client.CacheEntry{Body: []byte("b")})
+
+	// reading "a" makes "b" the least recently used entry
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+	c.Set("c", &client.CacheEntry{Body: []byte("c")})
+
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	entry, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("a"), entry.Body)
+	assert.Equal(t, 2, c.Len())
+}
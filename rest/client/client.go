@@ -2,7 +2,10 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -16,25 +19,40 @@ const clientVersion = "v1.16.0"
 const (
 	APIURL            = "https://api.massive.com"
 	DefaultRetryCount = 3
+	DefaultTimeout    = 10 * time.Second
 )
 
 // Client defines an HTTP client for the Massive REST API.
 type Client struct {
-	HTTP    *resty.Client
-	encoder *encoder.Encoder
+	HTTP       *resty.Client
+	encoder    *encoder.Encoder
+	middleware []Middleware
+	logger     Logger
+	traceAll   bool
+	cache      *responseCache
+	flights    *flightGroup
 }
 
 // New returns a new client with the specified API key and default settings.
-func New(apiKey string) Client {
-	return newClient(apiKey, nil)
+func New(apiKey string, opts ...Option) Client {
+	return newClient(apiKey, nil, opts...)
 }
 
 // NewWithClient returns a new client with the specified API key and a custom HTTP client.
-func NewWithClient(apiKey string, hc *http.Client) Client {
-	return newClient(apiKey, hc)
+func NewWithClient(apiKey string, hc *http.Client, opts ...Option) Client {
+	return newClient(apiKey, hc, opts...)
 }
 
-func newClient(apiKey string, hc *http.Client) Client {
+func newClient(apiKey string, hc *http.Client, opts ...Option) Client {
+	options := &Options{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	if hc == nil {
+		hc = options.HTTPClient
+	}
+
 	var c *resty.Client
 	if hc == nil {
 		c = resty.New()
@@ -42,17 +60,59 @@ func newClient(apiKey string, hc *http.Client) Client {
 		c = resty.NewWithClient(hc)
 	}
 
-	c.SetBaseURL(APIURL)
+	baseURL := APIURL
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	timeout := DefaultTimeout
+	if options.Timeout > 0 {
+		timeout = options.Timeout
+	}
+	userAgent := fmt.Sprintf("Massive.com GoClient/%v", clientVersion)
+	if options.UserAgentSuffix != "" {
+		userAgent += " " + options.UserAgentSuffix
+	}
+
+	c.SetBaseURL(baseURL)
 	c.SetAuthToken(apiKey)
 	c.SetRetryCount(DefaultRetryCount)
-	c.SetTimeout(10 * time.Second)
-	c.SetHeader("User-Agent", fmt.Sprintf("Massive.com GoClient/%v", clientVersion))
+	c.AddRetryHook(releaseStreamBody)
+	c.SetTimeout(timeout)
+	c.SetHeader("User-Agent", userAgent)
 	c.SetHeader("Accept-Encoding", "gzip")
+	for k, v := range options.Headers {
+		c.Header[http.CanonicalHeaderKey(k)] = v
+	}
+
+	if options.Proxy != "" {
+		c.SetProxy(options.Proxy)
+	}
+	if options.TLSConfig != nil {
+		c.SetTLSClientConfig(options.TLSConfig)
+	}
+
+	if options.RetryPolicy != nil {
+		options.RetryPolicy.install(c)
+	}
+	if options.RateLimiter != nil {
+		options.RateLimiter.install(c)
+	}
 
-	return Client{
-		HTTP:    c,
-		encoder: encoder.New(),
+	client := Client{
+		HTTP:       c,
+		encoder:    encoder.New(),
+		middleware: options.Middleware,
+		logger:     options.Logger,
+		traceAll:   options.Trace,
 	}
+	if options.Cache != nil {
+		client.cache = newResponseCache(*options.Cache)
+	}
+	if options.Coalesce {
+		client.flights = newFlightGroup()
+	}
+
+	return client
 }
 
 // Call makes an API call based on the request params and options. The response is automatically unmarshaled.
@@ -61,48 +121,199 @@ func (c *Client) Call(ctx context.Context, method, path string, params, response
 	if err != nil {
 		return err
 	}
-	return c.CallURL(ctx, method, uri, response, opts...)
+	return c.do(ctx, &Request{Method: method, Path: path, URI: uri, Options: mergeOptions(opts...), Result: response})
 }
 
 // CallURL makes an API call based on a request URI and options. The response is automatically unmarshaled.
 func (c *Client) CallURL(ctx context.Context, method, uri string, response any, opts ...models.RequestOption) error {
-	options := mergeOptions(opts...)
+	return c.do(ctx, &Request{Method: method, URI: uri, Options: mergeOptions(opts...), Result: response})
+}
+
+// CallPage makes an API call for a page of list results based on a request URI and options. It behaves like
+// CallURL but also passes the endpoint's path template on to middleware. The response is automatically unmarshaled.
+func (c *Client) CallPage(ctx context.Context, method, path, uri string, response any, opts ...models.RequestOption) error {
+	return c.do(ctx, &Request{Method: method, Path: path, URI: uri, Options: mergeOptions(opts...), Result: response})
+}
+
+// CallStream makes an API call based on the request params and options and returns the response body without
+// reading it, e.g. to download a file. The caller must close the body. Streamed responses aren't cached or shared
+// between concurrent calls.
+func (c *Client) CallStream(ctx context.Context, method, path string, params any, opts ...models.RequestOption) (io.ReadCloser, error) {
+	uri, err := c.encoder.EncodeParams(path, params)
+	if err != nil {
+		return nil, err
+	}
+	res, err := chain(c.execute, c.middleware)(ctx, &Request{Method: method, Path: path, URI: uri, Options: mergeOptions(opts...), Stream: true})
+	if err != nil {
+		if res != nil && res.Body != nil {
+			res.Body.Close() // a middleware failed the call after it succeeded
+		}
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// do runs a request through the middleware chain.
+func (c *Client) do(ctx context.Context, req *Request) error {
+	_, err := chain(c.execute, c.middleware)(ctx, req)
+	return err
+}
+
+// execute makes the HTTP request and decodes its response. It's the innermost round trip of the middleware chain.
+func (c *Client) execute(ctx context.Context, r *Request) (*Response, error) {
+	options := r.Options
+	if options == nil {
+		options = &models.RequestOptions{}
+	}
 
-	req := c.HTTP.R().SetContext(ctx)
+	req := c.HTTP.R().SetContext(withRetryStart(ctx))
 	if options.APIKey != nil {
 		req.SetAuthToken(*options.APIKey)
 	}
 	req.SetQueryParamsFromValues(options.QueryParams)
 	req.SetHeaderMultiValues(options.Headers)
-	req.SetResult(response).SetError(&models.ErrorResponse{})
+	req.SetResult(r.Result).SetError(&models.ErrorResponse{})
+	if r.Stream {
+		return c.stream(r, req, options)
+	}
+
+	var key string
+	var cached *CacheEntry
+	if c.cache != nil {
+		key = c.cache.key(r, options, c.HTTP.Token)
+	}
+	if key != "" && !options.BypassCache {
+		if entry, ok := c.cache.cache.Get(key); ok {
+			if time.Now().Before(entry.Expires) {
+				meta := c.cachedResponse(r, entry)
+				err := decodeBody(entry.Body, r.Result, meta)
+				if options.Trace || c.traceAll {
+					c.trace(r, req, meta, err)
+				}
+				return meta, err
+			}
+			if etag := entry.ETag(); etag != "" {
+				cached = entry
+				req.SetHeader("If-None-Match", etag)
+			}
+		}
+	}
+
+	fetch := func() ([]byte, *Response, error) {
+		res, err := req.Execute(r.Method, r.URI)
+		meta := newResponse(req, res)
+		err = c.checkResponse(req, res, meta, err)
+		if key != "" && err == nil {
+			err = c.cache.store(key, r, res, meta, cached)
+		}
+
+		var body []byte
+		if meta != nil && meta.FromCache {
+			body = cached.Body
+		} else if res != nil {
+			body = res.Body()
+		}
+		return body, meta, err
+	}
+
+	var meta *Response
+	var err error
+	if flightKey := c.flights.key(r, options, c.HTTP.Token); flightKey != "" {
+		meta, err = c.flights.do(ctx, flightKey, r.Result, fetch)
+	} else {
+		_, meta, err = fetch()
+	}
+
+	if options.Trace || c.traceAll {
+		c.trace(r, req, meta, err)
+	}
+
+	return meta, err
+}
+
+// newResponse returns the description of a response or nil if the request didn't reach the server.
+func newResponse(req *resty.Request, res *resty.Response) *Response {
+	if res == nil || res.RawResponse == nil {
+		return nil
+	}
+	return &Response{
+		StatusCode: res.StatusCode(),
+		Header:     res.Header(),
+		URL:        requestURL(res),
+		RequestID:  res.Header().Get("X-Request-ID"),
+		Attempts:   req.Attempt,
+		Size:       res.Size(),
+		Latency:    res.Time(),
+	}
+}
 
-	res, err := req.Execute(method, uri)
+// checkResponse converts failed requests and error responses into errors.
+func (c *Client) checkResponse(req *resty.Request, res *resty.Response, meta *Response, err error) error {
 	if err != nil {
+		if isDecodeError(err) && meta != nil {
+			return &models.DecodeError{
+				StatusCode: meta.StatusCode,
+				RequestID:  meta.RequestID,
+				URL:        meta.URL,
+				Attempts:   meta.Attempts,
+				Err:        err,
+			}
+		} else if req.Attempt > 1 {
+			return fmt.Errorf("failed to execute request after %d attempts: %w", req.Attempt, err)
+		}
 		return fmt.Errorf("failed to execute request: %w", err)
 	} else if res.IsError() {
 		errRes := res.Error().(*models.ErrorResponse)
 		errRes.StatusCode = res.StatusCode()
 		if errRes.RequestID == "" {
-			errRes.RequestID = res.Header().Get("X-Request-ID")
+			errRes.RequestID = meta.RequestID
+		} else {
+			meta.RequestID = errRes.RequestID
 		}
+		errRes.URL = meta.URL
+		errRes.Attempts = meta.Attempts
+		errRes.RetryAfter, _ = parseRetryAfter(res.Header().Get("Retry-After"), time.Now())
 		return errRes
 	}
 
-	if options.Trace {
-		fmt.Printf("Request URL: %s\n", uri)
-		sanitizedHeaders := req.Header
-		for k := range sanitizedHeaders {
-			if k == "Authorization" {
-				sanitizedHeaders[k] = []string{"REDACTED"}
-			}
+	return nil
+}
+
+// isDecodeError reports whether err was caused by a malformed response body.
+func isDecodeError(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
+
+// decodeBody unmarshals a response body that was already received (e.g. a cached or shared response) into the result.
+func decodeBody(body []byte, result any, meta *Response) error {
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		decodeErr := &models.DecodeError{Err: err}
+		if meta != nil {
+			decodeErr.StatusCode = meta.StatusCode
+			decodeErr.RequestID = meta.RequestID
+			decodeErr.URL = meta.URL
+			decodeErr.Attempts = meta.Attempts
 		}
-		fmt.Printf("Request Headers: %s\n", sanitizedHeaders)
-		fmt.Printf("Response Headers: %+v\n", res.Header())
+		return decodeErr
 	}
 
 	return nil
 }
 
+// requestURL returns the URL of the request that produced a response with any API key redacted.
+func requestURL(res *resty.Response) string {
+	if res.Request.RawRequest != nil && res.Request.RawRequest.URL != nil {
+		return redactURL(res.Request.RawRequest.URL)
+	}
+	return redactURI(res.Request.URL)
+}
+
 func mergeOptions(opts ...models.RequestOption) *models.RequestOptions {
 	options := &models.RequestOptions{}
 	for _, o := range opts {
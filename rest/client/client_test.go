@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallURLErrors(t *testing.T) {
+	tests := []struct {
+		status   int
+		expected error
+	}{
+		{status: 401, expected: models.ErrUnauthorized},
+		{status: 403, expected: models.ErrForbidden},
+		{status: 404, expected: models.ErrNotFound},
+		{status: 429, expected: models.ErrRateLimited},
+		{status: 500, expected: models.ErrServer},
+		{status: 502, expected: models.ErrServer},
+	}
+
+	for _, tt := range tests {
+		c := client.New("API_KEY")
+		httpmock.ActivateNonDefault(c.HTTP.GetClient())
+
+		httpmock.RegisterResponder("GET", resourceURL+"?apiKey=SECRET&ticker=AAPL",
+			httpmock.NewStringResponder(tt.status, `{"status":"ERROR","request_id":"req1","error":"something went wrong"}`).
+				HeaderAdd(http.Header{"Content-Type": []string{"application/json"}, "Retry-After": []string{"2"}}))
+
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource?apiKey=SECRET&ticker=AAPL", &models.BaseResponse{})
+		assert.True(t, errors.Is(err, tt.expected), "status %d", tt.status)
+
+		var errRes *models.ErrorResponse
+		if assert.True(t, errors.As(err, &errRes)) {
+			assert.Equal(t, tt.status, errRes.StatusCode)
+			assert.Equal(t, "req1", errRes.RequestID)
+			assert.Equal(t, resourceURL+"?apiKey=REDACTED&ticker=AAPL", errRes.URL)
+			assert.Equal(t, 2*time.Second, errRes.RetryAfter)
+			assert.Equal(t, tt.status == 429 || tt.status >= 500, errRes.Retryable())
+		}
+
+		httpmock.DeactivateAndReset()
+	}
+}
+
+func TestCallURLDecodeError(t *testing.T) {
+	c := client.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", resourceURL,
+		httpmock.NewStringResponder(200, `{"status": 1}`).
+			HeaderAdd(http.Header{"Content-Type": []string{"application/json"}, "X-Request-ID": []string{"req1"}}))
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.True(t, errors.Is(err, models.ErrDecode))
+	assert.False(t, errors.Is(err, models.ErrServer))
+
+	var decodeErr *models.DecodeError
+	if assert.True(t, errors.As(err, &decodeErr)) {
+		assert.Equal(t, 200, decodeErr.StatusCode)
+		assert.Equal(t, "req1", decodeErr.RequestID)
+		assert.Equal(t, resourceURL, decodeErr.URL)
+	}
+}
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return httpmock.NewStringResponder(status, body).
+		HeaderAdd(http.Header{"Content-Type": []string{"application/json"}})
+}
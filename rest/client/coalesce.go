@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// errFlightPanicked is the error that callers waiting for a shared request get if making it panicked.
+var errFlightPanicked = errors.New("shared request panicked")
+
+// fetchFunc makes an HTTP request, decodes its response into the caller's result and returns the raw body so
+// that it can be decoded again for other callers.
+type fetchFunc func() ([]byte, *Response, error)
+
+// flight is an in-flight request shared by concurrent identical calls.
+type flight struct {
+	done chan struct{}
+	body []byte
+	res  *Response
+	err  error
+}
+
+// flightGroup deduplicates concurrent identical requests so that only one of them reaches the server.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: make(map[string]*flight)}
+}
+
+// key returns the key that identifies identical requests or an empty string if the request can't be shared.
+// Only GET requests are shared. Requests made with different API keys, query params or headers are never shared.
+func (g *flightGroup) key(r *Request, options *models.RequestOptions, token string) string {
+	if g == nil || r.Method != http.MethodGet {
+		return ""
+	}
+
+	if options.APIKey != nil {
+		token = *options.APIKey
+	}
+
+	var b strings.Builder
+	b.WriteString(r.Method)
+	b.WriteString(" ")
+	b.WriteString(r.URI)
+	b.WriteString("\n")
+	b.WriteString(options.QueryParams.Encode())
+	b.WriteString("\n")
+	b.WriteString(token)
+	b.WriteString("\n")
+	_ = options.Headers.Write(&b)
+	return b.String()
+}
+
+// do makes the request unless an identical one is already in flight, in which case it waits for that request
+// and decodes its response body into result. A caller stops waiting once its own context is done. If the shared
+// request failed because another caller's context was canceled, the request is made again.
+func (g *flightGroup) do(ctx context.Context, key string, result any, fetch fetchFunc) (*Response, error) {
+	for {
+		g.mu.Lock()
+		f, ok := g.flights[key]
+		if !ok {
+			f = &flight{done: make(chan struct{})}
+			g.flights[key] = f
+			g.mu.Unlock()
+
+			g.fetch(key, f, fetch)
+			return f.res, f.err
+		}
+		g.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-f.done:
+		}
+
+		var res *Response
+		if f.res != nil {
+			cp := *f.res
+			cp.Header = f.res.Header.Clone()
+			cp.Shared = true
+			res = &cp
+		}
+
+		if f.err != nil {
+			if isContextError(f.err) && ctx.Err() == nil {
+				continue
+			}
+			if errRes, ok := f.err.(*models.ErrorResponse); ok {
+				cp := *errRes // callers may modify their error
+				return res, &cp
+			}
+			return res, f.err
+		}
+		return res, decodeBody(f.body, result, res)
+	}
+}
+
+// fetch makes the request of a flight and then removes it from the group and releases its waiters, even if fetch
+// panics, in which case they get an error while the panic propagates to the caller that made the request.
+func (g *flightGroup) fetch(key string, f *flight, fetch fetchFunc) {
+	f.err = errFlightPanicked
+	defer func() {
+		g.mu.Lock()
+		delete(g.flights, key)
+		g.mu.Unlock()
+		close(f.done)
+	}()
+
+	f.body, f.res, f.err = fetch()
+}
+
+// isContextError reports whether err was caused by a canceled or expired context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
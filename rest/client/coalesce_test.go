@@ -0,0 +1,215 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingResponder returns a responder that signals each request on started and waits for release before
+// responding.
+func blockingResponder(started chan<- struct{}, release <-chan struct{}) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		started <- struct{}{}
+		<-release
+		res := httpmock.NewStringResponse(200, `{"status":"OK","request_id":"req1"}`)
+		res.Header.Set("Content-Type", "application/json")
+		return res, nil
+	}
+}
+
+func TestCoalescing(t *testing.T) {
+	var mu sync.Mutex
+	var shared int
+	countShared := func(next client.RoundTrip) client.RoundTrip {
+		return func(ctx context.Context, req *client.Request) (*client.Response, error) {
+			res, err := next(ctx, req)
+			if res != nil && res.Shared {
+				mu.Lock()
+				shared++
+				mu.Unlock()
+			}
+			return res, err
+		}
+	}
+	c := client.New("API_KEY", client.WithCoalescing(true), client.WithMiddleware(countShared))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	httpmock.RegisterResponder("GET", resourceURL, blockingResponder(started, release))
+
+	const callers = 5
+	results := make([]*models.BaseResponse, callers)
+	var wg sync.WaitGroup
+	call := func(i int) {
+		defer wg.Done()
+		results[i] = &models.BaseResponse{}
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", results[i])
+		assert.Nil(t, err)
+	}
+
+	wg.Add(callers)
+	go call(0)
+	<-started
+	for i := 1; i < callers; i++ {
+		go call(i)
+	}
+	time.Sleep(50 * time.Millisecond) // let the other callers join the in-flight request
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.Equal(t, callers-1, shared)
+	for i := 1; i < callers; i++ {
+		assert.Equal(t, "req1", results[i].RequestID)
+		assert.NotSame(t, results[0], results[i])
+	}
+}
+
+func TestCoalescingDifferentAPIKeys(t *testing.T) {
+	c := client.New("API_KEY", client.WithCoalescing(true))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	httpmock.RegisterResponder("GET", resourceURL, blockingResponder(started, release))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for _, key := range []string{"KEY1", "KEY2"} {
+		go func(key string) {
+			defer wg.Done()
+			err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{}, models.APIKey(key))
+			assert.Nil(t, err)
+		}(key)
+	}
+
+	// both requests reach the server before either one is released
+	<-started
+	<-started
+	close(release)
+	wg.Wait()
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestCoalescingWaiterCanceled(t *testing.T) {
+	c := client.New("API_KEY", client.WithCoalescing(true))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	httpmock.RegisterResponder("GET", resourceURL, blockingResponder(started, release))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+		assert.Nil(t, err)
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := c.CallURL(ctx, http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	<-done
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestCoalescingErrorResponse(t *testing.T) {
+	c := client.New("API_KEY", client.WithCoalescing(true))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	httpmock.RegisterResponder("GET", resourceURL, func(req *http.Request) (*http.Response, error) {
+		started <- struct{}{}
+		<-release
+		res := httpmock.NewStringResponse(404, `{"status":"NOT_FOUND","request_id":"req1","error":"not found"}`)
+		res.Header.Set("Content-Type", "application/json")
+		return res, nil
+	})
+
+	const callers = 3
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	call := func(i int) {
+		defer wg.Done()
+		errs[i] = c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	}
+
+	wg.Add(callers)
+	go call(0)
+	<-started
+	for i := 1; i < callers; i++ {
+		go call(i)
+	}
+	time.Sleep(50 * time.Millisecond) // let the other callers join the in-flight request
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	for i := 1; i < callers; i++ {
+		assert.Equal(t, errs[0], errs[i])
+		assert.NotSame(t, errs[0], errs[i])
+	}
+}
+
+// panickingResult is a result that panics when a response is decoded into it.
+type panickingResult struct{}
+
+func (panickingResult) UnmarshalJSON([]byte) error {
+	panic("decoding failed")
+}
+
+func TestCoalescingPanic(t *testing.T) {
+	c := client.New("API_KEY", client.WithCoalescing(true))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	httpmock.RegisterResponder("GET", resourceURL, blockingResponder(started, release))
+
+	panicked := make(chan any, 1)
+	go func() {
+		defer func() { panicked <- recover() }()
+		_ = c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &panickingResult{})
+	}()
+	<-started
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	}()
+	time.Sleep(50 * time.Millisecond) // let the other caller join the in-flight request
+	close(release)
+
+	assert.Equal(t, "decoding failed", <-panicked)
+	select {
+	case err := <-done:
+		assert.ErrorContains(t, err, "shared request panicked")
+	case <-time.After(time.Second):
+		t.Fatal("waiting caller wasn't released")
+	}
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// Request describes an API call as it passes through the middleware chain.
+type Request struct {
+	// Method is the HTTP method of the call.
+	Method string
+
+	// Path is the path template of the endpoint (e.g. "/v3/trades/{ticker}"). It's empty for calls made via
+	// CallURL.
+	Path string
+
+	// URI is the request URI including encoded path and query params.
+	URI string
+
+	// Options are the merged request options. Middleware can change them (e.g. to rotate the API key) before
+	// passing the request on.
+	Options *models.RequestOptions
+
+	// Result is the model that the response body is decoded into. It's populated once the rest of the chain
+	// returns without an error.
+	Result any
+
+	// Stream reports whether the response body is returned unread in Response.Body instead of being decoded
+	// into Result (see Client.CallStream).
+	Stream bool
+}
+
+// Response describes the outcome of an API call.
+type Response struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Header contains the response headers.
+	Header http.Header
+
+	// URL is the final request URL with any API key redacted.
+	URL string
+
+	// RequestID is the ID that the server assigned to the request.
+	RequestID string
+
+	// Attempts is the number of attempts made, including retries.
+	Attempts int
+
+	// Size is the size of the response body in bytes.
+	Size int64
+
+	// Latency is the duration of the last attempt.
+	Latency time.Duration
+
+	// FromCache reports whether the response body came from the response cache, either without contacting the
+	// server or after the server confirmed that the cached response is still valid.
+	FromCache bool
+
+	// Shared reports whether the response was received by a concurrent identical call and shared with this one.
+	Shared bool
+
+	// Body is the unread response body of a successful streamed request. It's nil for other requests.
+	Body io.ReadCloser
+}
+
+// RoundTrip executes an API call. It returns a nil response if the request didn't reach the server.
+type RoundTrip func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a RoundTrip to add behavior (e.g. logging, metrics or caching) around every API call,
+// including the page requests made by list method iterators.
+type Middleware func(next RoundTrip) RoundTrip
+
+// chain wraps a round trip in the middleware so that the first one is the outermost.
+func chain(rt RoundTrip, middleware []Middleware) RoundTrip {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		rt = middleware[i](rt)
+	}
+	return rt
+}
@@ -0,0 +1,102 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type listResourceResponse struct {
+	models.BaseResponse
+	Results []string `json:"results,omitempty"`
+}
+
+type listResourceParams struct {
+	Ticker string `validate:"required" path:"ticker"`
+}
+
+func TestMiddleware(t *testing.T) {
+	var calls []string
+	record := func(name string) client.Middleware {
+		return func(next client.RoundTrip) client.RoundTrip {
+			return func(ctx context.Context, req *client.Request) (*client.Response, error) {
+				calls = append(calls, name+" "+req.Path+" "+req.URI)
+				res, err := next(ctx, req)
+				if assert.NotNil(t, res) {
+					assert.Equal(t, 200, res.StatusCode)
+					assert.Equal(t, 1, res.Attempts)
+				}
+				return res, err
+			}
+		}
+	}
+	rotate := func(next client.RoundTrip) client.RoundTrip {
+		return func(ctx context.Context, req *client.Request) (*client.Response, error) {
+			req.Options.APIKey = new(string)
+			*req.Options.APIKey = "ROTATED_KEY"
+			return next(ctx, req)
+		}
+	}
+	c := client.New("API_KEY", client.WithMiddleware(record("outer"), record("inner"), rotate))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.massive.com/v1/resource/AAPL",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "Bearer ROTATED_KEY", req.Header.Get("Authorization"))
+			return jsonResponder(200, `{"status":"OK","next_url":"https://api.massive.com/v1/resource/AAPL?cursor=NEXT","results":["a"]}`)(req)
+		})
+	httpmock.RegisterResponder("GET", "https://api.massive.com/v1/resource/AAPL?cursor=NEXT",
+		jsonResponder(200, `{"status":"OK","results":["b"]}`))
+
+	res := &listResourceResponse{}
+	err := c.Call(context.Background(), http.MethodGet, "/v1/resource/{ticker}", &listResourceParams{Ticker: "AAPL"}, res)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a"}, res.Results)
+	assert.Equal(t, []string{
+		"outer /v1/resource/{ticker} /v1/resource/AAPL",
+		"inner /v1/resource/{ticker} /v1/resource/AAPL",
+	}, calls)
+
+	// list iterators fetch every page through the middleware chain
+	calls = nil
+	it := iter.NewIter(context.Background(), "/v1/resource/{ticker}", &listResourceParams{Ticker: "AAPL"},
+		func(ctx context.Context, uri string) (iter.ListResponse, []string, error) {
+			res := &listResourceResponse{}
+			err := c.CallURL(ctx, http.MethodGet, uri, res)
+			return res, res.Results, err
+		})
+	var items []string
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"a", "b"}, items)
+	assert.Len(t, calls, 4)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	cached := func(next client.RoundTrip) client.RoundTrip {
+		return func(ctx context.Context, req *client.Request) (*client.Response, error) {
+			req.Result.(*models.BaseResponse).Status = "CACHED"
+			return &client.Response{StatusCode: 200}, nil
+		}
+	}
+	c := client.New("API_KEY", client.WithMiddleware(cached))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	res := &models.BaseResponse{}
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", res)
+	assert.Nil(t, err)
+	assert.Equal(t, "CACHED", res.Status)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
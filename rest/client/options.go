@@ -0,0 +1,166 @@
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+// Options are used to configure a client when it's created.
+type Options struct {
+	// HTTPClient is a custom HTTP client used to make requests
+	HTTPClient *http.Client
+
+	// BaseURL overrides the API URL, e.g. to point the client at a proxy or a local test server
+	BaseURL string
+
+	// Timeout is the time limit of a single request attempt
+	Timeout time.Duration
+
+	// Headers are applied to every request
+	Headers http.Header
+
+	// UserAgentSuffix is appended to the default User-Agent header
+	UserAgentSuffix string
+
+	// Proxy is the URL of a proxy server used for every request
+	Proxy string
+
+	// TLSConfig is the TLS configuration of the underlying transport
+	TLSConfig *tls.Config
+
+	// RateLimiter throttles every request made by the client, including retries
+	RateLimiter *RateLimiter
+
+	// RetryPolicy replaces the default retry behavior
+	RetryPolicy *RetryPolicy
+
+	// Middleware wraps every API call, in the order given
+	Middleware []Middleware
+
+	// Logger receives request traces. Omitting this prints traces to stdout.
+	Logger Logger
+
+	// Trace enables tracing for every request, not just the ones made with models.WithTrace
+	Trace bool
+
+	// Cache enables response caching for GET requests
+	Cache *CacheConfig
+
+	// Coalesce makes concurrent identical GET requests share a single request to the server
+	Coalesce bool
+}
+
+// Option changes the configuration of Options.
+type Option func(o *Options)
+
+// WithHTTPClient sets a custom HTTP client as an option.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(o *Options) {
+		o.HTTPClient = hc
+	}
+}
+
+// WithBaseURL sets the base URL of the API as an option.
+func WithBaseURL(baseURL string) Option {
+	return func(o *Options) {
+		o.BaseURL = baseURL
+	}
+}
+
+// WithTimeout sets the request timeout as an option.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.Timeout = timeout
+	}
+}
+
+// WithHeader sets a default header as an option.
+func WithHeader(key, value string) Option {
+	return func(o *Options) {
+		if o.Headers == nil {
+			o.Headers = make(http.Header)
+		}
+
+		o.Headers.Add(key, value)
+	}
+}
+
+// WithUserAgentSuffix appends a string (e.g. "myapp/1.0") to the client's User-Agent header.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(o *Options) {
+		o.UserAgentSuffix = suffix
+	}
+}
+
+// WithProxy sets a proxy URL as an option.
+func WithProxy(proxyURL string) Option {
+	return func(o *Options) {
+		o.Proxy = proxyURL
+	}
+}
+
+// WithTLSConfig sets the transport's TLS configuration as an option.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *Options) {
+		o.TLSConfig = config
+	}
+}
+
+// WithRateLimit limits the rate at which the client makes requests. All copies of the client, such as the
+// domain specific clients embedded in the top level REST client, share the same request budget.
+func WithRateLimit(limit RateLimit) Option {
+	return WithRateLimiter(NewRateLimiter(limit))
+}
+
+// WithRateLimiter sets a rate limiter as an option. Use this to share a request budget between several clients.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(o *Options) {
+		o.RateLimiter = l
+	}
+}
+
+// WithRetryPolicy sets a retry policy as an option.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(o *Options) {
+		o.RetryPolicy = &p
+	}
+}
+
+// WithMiddleware adds middleware to the client as an option. The first middleware is the outermost one.
+func WithMiddleware(mw ...Middleware) Option {
+	return func(o *Options) {
+		o.Middleware = append(o.Middleware, mw...)
+	}
+}
+
+// WithLogger sets the logger that request traces are written to as an option.
+func WithLogger(l Logger) Option {
+	return func(o *Options) {
+		o.Logger = l
+	}
+}
+
+// WithTrace enables or disables tracing for every request as an option.
+func WithTrace(trace bool) Option {
+	return func(o *Options) {
+		o.Trace = trace
+	}
+}
+
+// WithCache enables response caching as an option. Pass an empty config to cache the reference endpoints of
+// DefaultCachePaths in memory, and set CacheConfig.AllPaths to cache every GET request instead.
+func WithCache(config CacheConfig) Option {
+	return func(o *Options) {
+		o.Cache = &config
+	}
+}
+
+// WithCoalescing enables or disables request coalescing as an option. When it's enabled, concurrent GET calls with
+// the same method, URI, query params, headers and API key share a single in-flight request, and each caller
+// decodes its own copy of the response.
+func WithCoalescing(coalesce bool) Option {
+	return func(o *Options) {
+		o.Coalesce = coalesce
+	}
+}
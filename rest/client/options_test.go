@@ -0,0 +1,57 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions(t *testing.T) {
+	var req *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"OK"}`))
+	}))
+	defer srv.Close()
+
+	c := client.New("API_KEY",
+		client.WithBaseURL(srv.URL),
+		client.WithTimeout(time.Second),
+		client.WithHeader("X-Custom-Header", "value"),
+		client.WithUserAgentSuffix("myapp/1.0"))
+
+	res := &models.BaseResponse{}
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", res)
+	assert.Nil(t, err)
+	assert.Equal(t, "OK", res.Status)
+
+	if assert.NotNil(t, req) {
+		assert.Equal(t, "/v1/resource", req.URL.Path)
+		assert.Equal(t, "value", req.Header.Get("X-Custom-Header"))
+		assert.Equal(t, "Bearer API_KEY", req.Header.Get("Authorization"))
+		assert.True(t, strings.HasPrefix(req.Header.Get("User-Agent"), "Massive.com GoClient/"))
+		assert.True(t, strings.HasSuffix(req.Header.Get("User-Agent"), " myapp/1.0"))
+	}
+	assert.Equal(t, time.Second, c.HTTP.GetClient().Timeout)
+}
+
+func TestOptionsTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	c := client.New("API_KEY", client.WithBaseURL(srv.URL), client.WithTimeout(20*time.Millisecond))
+	c.HTTP.SetRetryCount(0)
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RateLimit defines the request budget of a RateLimiter.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained number of requests allowed per second.
+	RequestsPerSecond float64
+
+	// RequestsPerMinute is the sustained number of requests allowed per minute. It's only used if
+	// RequestsPerSecond is unset, which is convenient for plans that are metered per minute.
+	RequestsPerMinute float64
+
+	// Burst is the maximum number of requests that can be made at once after a period of inactivity.
+	// Omitting this defaults to a burst of 1.
+	Burst int
+}
+
+// RateLimiter is a client side token bucket that spaces out requests to stay within a RateLimit. A single
+// limiter is safe for concurrent use and can be shared by multiple clients. Besides its configured rate, the
+// limiter also pauses all requests when the server responds with a Retry-After header.
+type RateLimiter struct {
+	mu          sync.Mutex
+	rate        float64 // tokens per second, zero means unlimited
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	now         func() time.Time
+}
+
+// NewRateLimiter returns a new limiter for the given rate limit. A zero rate limit doesn't restrict the request
+// rate but still honors Retry-After headers.
+func NewRateLimiter(limit RateLimit) *RateLimiter {
+	rate := limit.RequestsPerSecond
+	if rate <= 0 && limit.RequestsPerMinute > 0 {
+		rate = limit.RequestsPerMinute / 60
+	}
+	if rate < 0 {
+		rate = 0
+	}
+
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		now:    time.Now,
+	}
+}
+
+// Wait blocks until a request is allowed to proceed or the context is done, in which case the context's error
+// is returned.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.take()
+		if delay <= 0 {
+			return ctx.Err()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// PauseUntil holds back all requests until the given time. Earlier deadlines than the current one are ignored.
+func (l *RateLimiter) PauseUntil(t time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !t.After(l.pausedUntil) {
+		return
+	}
+
+	l.pausedUntil = t
+	l.last = t
+	l.tokens = math.Min(l.tokens, 1)
+}
+
+// take consumes a token if one is available, otherwise it returns how long to wait before trying again.
+func (l *RateLimiter) take() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	if l.rate == 0 {
+		return 0
+	}
+
+	if now.After(l.last) {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+	}
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// observe pauses the limiter if a throttled response tells the client when to retry.
+func (l *RateLimiter) observe(res *http.Response) {
+	if res == nil {
+		return
+	}
+	if res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusServiceUnavailable {
+		return
+	}
+
+	if d, ok := parseRetryAfter(res.Header.Get("Retry-After"), l.now()); ok {
+		l.PauseUntil(l.now().Add(d))
+	}
+}
+
+// install registers hooks that apply the limiter to every request attempt made by the HTTP client.
+func (l *RateLimiter) install(c *resty.Client) {
+	c.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		return l.Wait(req.Context())
+	})
+	c.OnAfterResponse(func(_ *resty.Client, res *resty.Response) error {
+		l.observe(res.RawResponse)
+		return nil
+	})
+
+	// resty doesn't run after-response hooks for streamed calls, so their responses are observed when an attempt
+	// is retried and once the call succeeds instead
+	c.AddRetryHook(func(res *resty.Response, _ error) {
+		if res != nil && isStream(res.Request) {
+			l.observe(res.RawResponse)
+		}
+	})
+	c.OnSuccess(func(_ *resty.Client, res *resty.Response) {
+		if isStream(res.Request) {
+			l.observe(res.RawResponse)
+		}
+	})
+}
+
+// parseRetryAfter parses a Retry-After header value which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
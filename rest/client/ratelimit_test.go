@@ -0,0 +1,91 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const resourceURL = "https://api.massive.com/v1/resource"
+
+func TestRateLimit(t *testing.T) {
+	c := client.New("API_KEY", client.WithRateLimit(client.RateLimit{RequestsPerSecond: 20, Burst: 2}))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, httpmock.NewStringResponder(200, `{"status":"OK"}`))
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+		assert.Nil(t, err)
+	}
+
+	// the burst covers the first two requests and the remaining two are spaced out by 50ms each
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+func TestRateLimitSharedBetweenCopies(t *testing.T) {
+	c := client.New("API_KEY", client.WithRateLimit(client.RateLimit{RequestsPerMinute: 1}))
+	cp := c
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, httpmock.NewStringResponder(200, `{"status":"OK"}`))
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = cp.CallURL(ctx, http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestRateLimitRetryAfter(t *testing.T) {
+	c := client.New("API_KEY", client.WithRateLimit(client.RateLimit{}))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	throttled := httpmock.NewStringResponder(429, `{"status":"ERROR","error":"too many requests"}`).
+		HeaderSet(http.Header{"Retry-After": []string{"1"}})
+	httpmock.RegisterResponder("GET", resourceURL, throttled.Then(httpmock.NewStringResponder(200, `{"status":"OK"}`)))
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.NotNil(t, err)
+
+	start := time.Now()
+	err = c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestRateLimitRetryAfterStream(t *testing.T) {
+	c := client.New("API_KEY", client.WithRateLimit(client.RateLimit{}))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	throttled := httpmock.NewStringResponder(429, `{"status":"ERROR","error":"too many requests"}`).
+		HeaderSet(http.Header{"Retry-After": []string{"1"}})
+	httpmock.RegisterResponder("GET", resourceURL, throttled.Then(httpmock.NewStringResponder(200, `{"status":"OK"}`)))
+
+	// a throttled streamed call pauses the limiter for the calls after it
+	_, err := c.CallStream(context.Background(), http.MethodGet, "/v1/resource", &struct{}{})
+	assert.ErrorIs(t, err, models.ErrRateLimited)
+
+	start := time.Now()
+	err = c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
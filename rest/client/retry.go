@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy defines when and how often failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a single request, including the first one. Omitting
+	// this defaults to DefaultRetryCount retries.
+	MaxAttempts int
+
+	// StatusCodes are the HTTP status codes that trigger a retry.
+	StatusCodes []int
+
+	// RetryNetworkErrors enables retries when a request fails before a response is received.
+	RetryNetworkErrors bool
+
+	// RetryNonIdempotent enables retries for methods that aren't idempotent (e.g. POST). By default only
+	// GET, HEAD, OPTIONS, PUT and DELETE requests are retried.
+	RetryNonIdempotent bool
+
+	// InitialBackoff is the wait time before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the wait time between two attempts. A Retry-After header sent by the server takes
+	// precedence over the computed backoff but is still capped.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor by which the backoff grows after each attempt. Omitting this defaults to 2.
+	Multiplier float64
+
+	// Jitter is the fraction (between 0 and 1) of each backoff that's randomized to spread out retries.
+	Jitter float64
+
+	// MaxElapsedTime stops retrying once this much time has passed since the first attempt. Omitting this
+	// means there's no limit other than MaxAttempts.
+	MaxElapsedTime time.Duration
+
+	// OnRetry is an optional hook that's called before every retry with the number of the attempt that
+	// failed and its response or error.
+	OnRetry func(attempt int, res *http.Response, err error)
+}
+
+// DefaultRetryPolicy returns a policy that retries network errors, rate limited requests and server errors
+// with an exponential backoff.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultRetryCount + 1,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+		InitialBackoff:     100 * time.Millisecond,
+		MaxBackoff:         5 * time.Second,
+		Multiplier:         2,
+		Jitter:             0.5,
+	}
+}
+
+type retryStartKey struct{}
+
+// install configures the HTTP client to retry requests according to the policy.
+func (p RetryPolicy) install(c *resty.Client) {
+	attempts := p.MaxAttempts
+	if attempts <= 0 {
+		attempts = DefaultRetryCount + 1
+	}
+
+	c.SetRetryCount(attempts - 1)
+	c.SetRetryWaitTime(0)
+	c.SetRetryMaxWaitTime(time.Duration(math.MaxInt64))
+	c.AddRetryCondition(p.shouldRetry)
+	c.SetRetryAfter(func(_ *resty.Client, res *resty.Response) (time.Duration, error) {
+		return p.backoff(res), nil
+	})
+
+	if p.OnRetry != nil {
+		c.AddRetryHook(func(res *resty.Response, err error) {
+			var raw *http.Response
+			attempt := 0
+			if res != nil {
+				raw = res.RawResponse
+				attempt = res.Request.Attempt
+			}
+			if attempt >= attempts {
+				return // resty runs retry hooks after the last attempt too
+			}
+			p.OnRetry(attempt, raw, err)
+		})
+	}
+}
+
+// withRetryStart records the start of a call so that the policy can enforce MaxElapsedTime.
+func withRetryStart(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryStartKey{}, time.Now())
+}
+
+func (p RetryPolicy) shouldRetry(res *resty.Response, err error) bool {
+	if res == nil || res.Request == nil {
+		return false // the request was aborted before it was sent
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(res.Request.Method) {
+		return false
+	}
+
+	if p.MaxElapsedTime > 0 {
+		if start, ok := res.Request.Context().Value(retryStartKey{}).(time.Time); ok && time.Since(start) >= p.MaxElapsedTime {
+			return false
+		}
+	}
+
+	if err != nil {
+		return p.RetryNetworkErrors && !isDecodeError(err)
+	}
+
+	for _, code := range p.StatusCodes {
+		if res.StatusCode() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt.
+func (p RetryPolicy) backoff(res *resty.Response) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	wait := float64(p.InitialBackoff) * math.Pow(multiplier, float64(res.Request.Attempt-1))
+	if p.MaxBackoff > 0 {
+		wait = math.Min(wait, float64(p.MaxBackoff))
+	}
+	if p.Jitter > 0 {
+		wait -= wait * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+
+	d := time.Duration(wait)
+	if res.RawResponse != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header().Get("Retry-After"), time.Now()); ok && retryAfter > d {
+			d = retryAfter
+			if p.MaxBackoff > 0 && d > p.MaxBackoff {
+				d = p.MaxBackoff
+			}
+		}
+	}
+
+	if p.MaxElapsedTime > 0 {
+		if start, ok := res.Request.Context().Value(retryStartKey{}).(time.Time); ok {
+			if remaining := p.MaxElapsedTime - time.Since(start); d > remaining {
+				d = remaining
+			}
+		}
+	}
+
+	if d <= 0 {
+		d = time.Nanosecond // resty falls back to its own backoff if this is zero
+	}
+	return d
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
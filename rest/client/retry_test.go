@@ -0,0 +1,104 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRetryPolicy() client.RetryPolicy {
+	p := client.DefaultRetryPolicy()
+	p.InitialBackoff = time.Millisecond
+	p.MaxBackoff = 10 * time.Millisecond
+	return p
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var retries []int
+	p := testRetryPolicy()
+	p.OnRetry = func(attempt int, res *http.Response, err error) {
+		assert.Nil(t, err)
+		assert.Equal(t, 503, res.StatusCode)
+		retries = append(retries, attempt)
+	}
+	c := client.New("API_KEY", client.WithRetryPolicy(p))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	unavailable := jsonResponder(503, `{"status":"ERROR"}`)
+	httpmock.RegisterResponder("GET", resourceURL,
+		unavailable.Then(unavailable).Then(jsonResponder(200, `{"status":"OK"}`)))
+
+	res := &models.BaseResponse{}
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", res)
+	assert.Nil(t, err)
+	assert.Equal(t, "OK", res.Status)
+	assert.Equal(t, []int{1, 2}, retries)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestRetryPolicyMaxAttempts(t *testing.T) {
+	var retries []int
+	p := testRetryPolicy()
+	p.MaxAttempts = 2
+	p.OnRetry = func(attempt int, _ *http.Response, _ error) {
+		retries = append(retries, attempt)
+	}
+	c := client.New("API_KEY", client.WithRetryPolicy(p))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, jsonResponder(500, `{"status":"ERROR"}`))
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	var errRes *models.ErrorResponse
+	if assert.True(t, errors.As(err, &errRes)) {
+		assert.Equal(t, 500, errRes.StatusCode)
+		assert.Equal(t, 2, errRes.Attempts)
+	}
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	assert.Equal(t, []int{1}, retries, "OnRetry is only called before retries")
+}
+
+func TestRetryPolicySkipsNonRetryable(t *testing.T) {
+	c := client.New("API_KEY", client.WithRetryPolicy(testRetryPolicy()))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, jsonResponder(404, `{"status":"NOT_FOUND"}`))
+	httpmock.RegisterResponder("POST", resourceURL, jsonResponder(503, `{"status":"ERROR"}`))
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.True(t, errors.Is(err, models.ErrNotFound))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	err = c.CallURL(context.Background(), http.MethodPost, "/v1/resource", &models.BaseResponse{})
+	assert.True(t, errors.Is(err, models.ErrServer))
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestRetryPolicyMaxElapsedTime(t *testing.T) {
+	p := testRetryPolicy()
+	p.MaxAttempts = 100
+	p.InitialBackoff = 20 * time.Millisecond
+	p.MaxBackoff = 20 * time.Millisecond
+	p.Jitter = 0
+	p.MaxElapsedTime = 50 * time.Millisecond
+	c := client.New("API_KEY", client.WithRetryPolicy(p))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, jsonResponder(429, `{"status":"ERROR"}`))
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.True(t, errors.Is(err, models.ErrRateLimited))
+	assert.LessOrEqual(t, httpmock.GetTotalCallCount(), 4)
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// streamKey is the context key of the state of a streamed call.
+type streamKey struct{}
+
+// streamState holds the error response of a streamed call that was read by releaseStreamBody.
+type streamState struct {
+	res  *http.Response
+	body []byte
+}
+
+// stream makes the HTTP request of a streamed call. Error responses are read and returned as errors like any other,
+// but the body of a successful response is left for the caller to read.
+func (c *Client) stream(r *Request, req *resty.Request, options *models.RequestOptions) (*Response, error) {
+	state := &streamState{}
+	req.SetContext(context.WithValue(req.Context(), streamKey{}, state))
+	req.SetDoNotParseResponse(true)
+
+	res, err := req.Execute(r.Method, r.URI)
+	meta := newResponse(req, res)
+	if err == nil && res.IsError() {
+		body := state.body
+		if state.res != res.RawResponse {
+			body, _ = readBody(res.RawResponse)
+			res.RawResponse.Body.Close()
+		}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, res.Request.Error); err != nil {
+				res.Request.Error.(*models.ErrorResponse).ErrorMessage = string(body)
+			}
+		}
+	}
+
+	err = c.checkResponse(req, res, meta, err)
+	if err == nil {
+		meta.Body, err = decompress(res.RawResponse)
+	} else if meta != nil {
+		res.RawResponse.Body.Close()
+	}
+
+	if options.Trace || c.traceAll {
+		c.trace(r, req, meta, err)
+	}
+
+	return meta, err
+}
+
+// releaseStreamBody is a retry hook that reads and closes the body of a streamed error response before the request
+// is retried, so that its connection is released. Resty also runs retry hooks after the last attempt, so the body
+// is kept for stream to decode.
+func releaseStreamBody(res *resty.Response, _ error) {
+	if res == nil || res.RawResponse == nil || !res.IsError() {
+		return
+	}
+	if state, ok := res.Request.Context().Value(streamKey{}).(*streamState); ok && state.res != res.RawResponse {
+		state.res = res.RawResponse
+		state.body, _ = readBody(res.RawResponse)
+		res.RawResponse.Body.Close()
+	}
+}
+
+// isStream reports whether a request was made by a streamed call.
+func isStream(req *resty.Request) bool {
+	_, ok := req.Context().Value(streamKey{}).(*streamState)
+	return ok
+}
+
+// readBody reads and decompresses the body of a response.
+func readBody(res *http.Response) ([]byte, error) {
+	body, err := decompress(res)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(body)
+}
+
+// decompress returns the body of a response, decompressing it if it's gzipped. The transport doesn't do it since the
+// client sets the Accept-Encoding header itself.
+func decompress(res *http.Response) (io.ReadCloser, error) {
+	if !strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") || res.ContentLength == 0 {
+		return res.Body, nil
+	}
+
+	zr, err := gzip.NewReader(res.Body)
+	if errors.Is(err, io.EOF) {
+		res.Body.Close()
+		return http.NoBody, nil
+	} else if err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+	return &gzipBody{Reader: zr, body: res.Body}, nil
+}
+
+// gzipBody is a decompressed response body.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+// Close closes the decompressor and the underlying body.
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
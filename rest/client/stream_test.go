@@ -0,0 +1,113 @@
+package client_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type streamParams struct {
+	ID string `validate:"required" path:"id"`
+}
+
+func TestCallStream(t *testing.T) {
+	var seen *client.Response
+	capture := func(next client.RoundTrip) client.RoundTrip {
+		return func(ctx context.Context, req *client.Request) (*client.Response, error) {
+			assert.True(t, req.Stream)
+			res, err := next(ctx, req)
+			seen = res
+			return res, err
+		}
+	}
+	c := client.New("API_KEY", client.WithMiddleware(capture))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL, httpmock.NewStringResponder(200, "<html>filing</html>"))
+
+	body, err := c.CallStream(context.Background(), http.MethodGet, "/v1/{id}", &streamParams{ID: "resource"})
+	require.Nil(t, err)
+	data, err := io.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Nil(t, body.Close())
+	assert.Equal(t, "<html>filing</html>", string(data))
+	if assert.NotNil(t, seen) {
+		assert.Equal(t, 200, seen.StatusCode)
+	}
+}
+
+func TestCallStreamGzip(t *testing.T) {
+	c := client.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	_, err := zw.Write([]byte("compressed filing"))
+	require.Nil(t, err)
+	require.Nil(t, zw.Close())
+	httpmock.RegisterResponder("GET", resourceURL, httpmock.NewBytesResponder(200, compressed.Bytes()).
+		HeaderAdd(http.Header{"Content-Encoding": []string{"gzip"}}))
+
+	body, err := c.CallStream(context.Background(), http.MethodGet, "/v1/{id}", &streamParams{ID: "resource"})
+	require.Nil(t, err)
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Equal(t, "compressed filing", string(data))
+}
+
+func TestCallStreamErrors(t *testing.T) {
+	c := client.New("API_KEY", client.WithRetryPolicy(testRetryPolicy()))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	unavailable := jsonResponder(503, `{"status":"ERROR","error":"try again"}`)
+	httpmock.RegisterResponder("GET", resourceURL,
+		unavailable.Then(jsonResponder(404, `{"status":"NOT_FOUND","request_id":"abc","error":"file not found"}`)))
+
+	body, err := c.CallStream(context.Background(), http.MethodGet, "/v1/{id}", &streamParams{ID: "resource"})
+	assert.Nil(t, body)
+	assert.True(t, errors.Is(err, models.ErrNotFound))
+	var errRes *models.ErrorResponse
+	if assert.True(t, errors.As(err, &errRes)) {
+		assert.Equal(t, 404, errRes.StatusCode)
+		assert.Equal(t, "abc", errRes.RequestID)
+		assert.Equal(t, "file not found", errRes.ErrorMessage)
+		assert.Equal(t, 2, errRes.Attempts)
+	}
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	_, err = c.CallStream(context.Background(), http.MethodGet, "/v1/{id}", &streamParams{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestCallStreamRetriesNetworkErrors(t *testing.T) {
+	c := client.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", resourceURL,
+		httpmock.NewErrorResponder(errors.New("connection reset by peer")).Then(httpmock.NewStringResponder(200, "<html>filing</html>")))
+
+	body, err := c.CallStream(context.Background(), http.MethodGet, "/v1/{id}", &streamParams{ID: "resource"})
+	require.Nil(t, err)
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Equal(t, "<html>filing</html>", string(data))
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
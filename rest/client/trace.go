@@ -0,0 +1,102 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Logger is a basic logger interface used for tracing requests. It has the same shape as the logger of the
+// WebSocket client, so a single implementation can be shared by both.
+type Logger interface {
+	Debugf(template string, args ...any)
+	Infof(template string, args ...any)
+	Errorf(template string, args ...any)
+}
+
+// stdoutLogger is the logger used for tracing if no other logger is configured.
+type stdoutLogger struct{}
+
+func (l *stdoutLogger) Debugf(template string, args ...any) { fmt.Printf(template+"\n", args...) }
+func (l *stdoutLogger) Infof(template string, args ...any)  { fmt.Printf(template+"\n", args...) }
+func (l *stdoutLogger) Errorf(template string, args ...any) { fmt.Printf(template+"\n", args...) }
+
+// trace logs the details of a request and its outcome. Credentials are redacted from both the URL and headers.
+func (c *Client) trace(r *Request, req *resty.Request, res *Response, err error) {
+	log := c.logger
+	if log == nil {
+		log = &stdoutLogger{}
+	}
+
+	reqURL := redactURI(r.URI)
+	if res != nil {
+		reqURL = res.URL
+	} else if req.RawRequest != nil && req.RawRequest.URL != nil {
+		reqURL = redactURL(req.RawRequest.URL)
+	}
+
+	log.Debugf("Request URL: %s", reqURL)
+	headers := req.Header
+	if req.RawRequest != nil {
+		headers = req.RawRequest.Header
+	}
+	log.Debugf("Request Headers: %s", redactHeaders(headers))
+	if res != nil {
+		log.Debugf("Response Headers: %+v", res.Header)
+	}
+
+	if res == nil {
+		log.Errorf("%s %s failed: attempts %d: %v", r.Method, reqURL, req.Attempt, err)
+		return
+	}
+
+	summary := fmt.Sprintf("%s %s: status %d: latency %s: bytes %d: attempts %d: request ID '%s'",
+		r.Method, reqURL, res.StatusCode, res.Latency, res.Size, res.Attempts, res.RequestID)
+	if err != nil {
+		log.Errorf("%s: %v", summary, err)
+		return
+	}
+	log.Infof("%s", summary)
+}
+
+// redactHeaders returns a copy of the headers with credentials redacted.
+func redactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+	for k := range redacted {
+		if strings.EqualFold(k, "Authorization") {
+			redacted[k] = []string{"REDACTED"}
+		}
+	}
+	return redacted
+}
+
+// redactURI returns the request URI with any API key query param redacted.
+func redactURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	return redactURL(u)
+}
+
+// redactURL returns a string representation of the URL with any API key query param redacted.
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	redacted := false
+	for k := range query {
+		if strings.EqualFold(k, "apiKey") {
+			query[k] = []string{"REDACTED"}
+			redacted = true
+		}
+	}
+	if !redacted {
+		return u.String()
+	}
+
+	cp := *u
+	cp.RawQuery = query.Encode()
+	return cp.String()
+}
@@ -0,0 +1,80 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Debugf(template string, args ...any) {
+	l.lines = append(l.lines, "DEBUG "+fmt.Sprintf(template, args...))
+}
+
+func (l *recordingLogger) Infof(template string, args ...any) {
+	l.lines = append(l.lines, "INFO "+fmt.Sprintf(template, args...))
+}
+
+func (l *recordingLogger) Errorf(template string, args ...any) {
+	l.lines = append(l.lines, "ERROR "+fmt.Sprintf(template, args...))
+}
+
+func (l *recordingLogger) String() string {
+	return strings.Join(l.lines, "\n")
+}
+
+func TestTrace(t *testing.T) {
+	log := &recordingLogger{}
+	c := client.New("API_KEY", client.WithLogger(log))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", resourceURL+"?apiKey=SECRET",
+		httpmock.NewStringResponder(200, `{"status":"OK"}`).
+			HeaderAdd(http.Header{"Content-Type": []string{"application/json"}, "X-Request-ID": []string{"req1"}}))
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{},
+		models.QueryParam("apiKey", "SECRET"), models.WithTrace(true))
+	assert.Nil(t, err)
+
+	out := log.String()
+	assert.Contains(t, out, "DEBUG Request URL: "+resourceURL+"?apiKey=REDACTED")
+	assert.Contains(t, out, "Authorization:[REDACTED]")
+	assert.Contains(t, out, "INFO GET "+resourceURL+"?apiKey=REDACTED: status 200")
+	assert.Contains(t, out, "attempts 1: request ID 'req1'")
+	assert.NotContains(t, out, "SECRET")
+	assert.NotContains(t, out, "API_KEY")
+
+	// requests without the trace option aren't logged
+	log.lines = nil
+	err = c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{}, models.QueryParam("apiKey", "SECRET"))
+	assert.Nil(t, err)
+	assert.Empty(t, log.lines)
+}
+
+func TestTraceFailure(t *testing.T) {
+	log := &recordingLogger{}
+	c := client.New("API_KEY", client.WithLogger(log), client.WithTrace(true))
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", resourceURL, jsonResponder(404, `{"status":"NOT_FOUND","request_id":"req1","error":"not found"}`))
+
+	err := c.CallURL(context.Background(), http.MethodGet, "/v1/resource", &models.BaseResponse{})
+	assert.NotNil(t, err)
+	assert.Contains(t, log.String(), "ERROR GET "+resourceURL+": status 404")
+	assert.Contains(t, log.String(), "request ID 'req1'")
+	assert.Contains(t, log.String(), "message 'not found'")
+}
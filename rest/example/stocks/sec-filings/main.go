@@ -0,0 +1,62 @@
+// Stocks - SEC Filings
+// https://github.com/massive-com/client-go/v2/blob/master/rest/sec_gen.go
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+func main() {
+
+	// init client
+	c := massive.New(os.Getenv("MASSIVE_API_KEY"))
+
+	// set params
+	params := models.ListSECFilingsParams{}.
+		WithEntitiesCompanyDataTicker("AAPL").
+		WithType("10-K").
+		WithLimit(1)
+
+	// make request
+	filings := c.ListSECFilings(context.Background(), params)
+	if !filings.Next() {
+		if filings.Err() != nil {
+			log.Fatal(filings.Err())
+		}
+		log.Fatal("no filings found")
+	}
+	filing := filings.Item()
+	log.Print(filing)
+
+	// list the files of the filing
+	files := c.ListSECFilingFiles(context.Background(), &models.ListSECFilingFilesParams{FilingID: filing.ID})
+	for files.Next() {
+		file := files.Item()
+		log.Print(file)
+
+		// download the first file
+		if file.Sequence == 1 {
+			body, err := c.GetSECFilingFile(context.Background(), &models.GetSECFilingFileParams{
+				FilingID: filing.ID,
+				FileID:   file.ID,
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if _, err := io.Copy(os.Stdout, body); err != nil {
+				log.Fatal(err)
+			}
+			body.Close()
+		}
+	}
+	if files.Err() != nil {
+		log.Fatal(files.Err())
+	}
+
+}
@@ -30,20 +30,20 @@ type FuturesClient struct {
 
 // ListFuturesAggs retrieves a list of aggregates for a futures contract.
 func (fc *FuturesClient) ListFuturesAggs(ctx context.Context, params *models.ListFuturesAggsParams, options ...models.RequestOption) *iter.Iter[models.FuturesAggregate] {
-	return iter.NewIter(ctx, ListFuturesAggsPath, params, func(uri string) (iter.ListResponse, []models.FuturesAggregate, error) {
+	return iter.NewIter(ctx, ListFuturesAggsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.FuturesAggregate, error) {
 		res := &models.ListFuturesAggsResponse{}
-		err := fc.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := fc.CallPage(ctx, http.MethodGet, ListFuturesAggsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListFuturesContracts retrieves a list of futures contracts.
 func (fc *FuturesClient) ListFuturesContracts(ctx context.Context, params *models.ListFuturesContractsParams, options ...models.RequestOption) *iter.Iter[models.FuturesContract] {
-	return iter.NewIter(ctx, ListFuturesContractsPath, params, func(uri string) (iter.ListResponse, []models.FuturesContract, error) {
+	return iter.NewIter(ctx, ListFuturesContractsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.FuturesContract, error) {
 		res := &models.ListFuturesContractsResponse{}
-		err := fc.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := fc.CallPage(ctx, http.MethodGet, ListFuturesContractsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetFuturesContract retrieves details for a specific futures contract.
@@ -55,20 +55,20 @@ func (fc *FuturesClient) GetFuturesContract(ctx context.Context, params *models.
 
 // ListFuturesMarketStatuses retrieves market statuses for futures products.
 func (fc *FuturesClient) ListFuturesMarketStatuses(ctx context.Context, params *models.ListFuturesMarketStatusesParams, options ...models.RequestOption) *iter.Iter[models.FuturesMarketStatus] {
-	return iter.NewIter(ctx, ListFuturesMarketStatusesPath, params, func(uri string) (iter.ListResponse, []models.FuturesMarketStatus, error) {
+	return iter.NewIter(ctx, ListFuturesMarketStatusesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.FuturesMarketStatus, error) {
 		res := &models.ListFuturesMarketStatusesResponse{}
-		err := fc.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := fc.CallPage(ctx, http.MethodGet, ListFuturesMarketStatusesPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListFuturesProducts retrieves a list of futures products.
 func (fc *FuturesClient) ListFuturesProducts(ctx context.Context, params *models.ListFuturesProductsParams, options ...models.RequestOption) *iter.Iter[models.FuturesProduct] {
-	return iter.NewIter(ctx, ListFuturesProductsPath, params, func(uri string) (iter.ListResponse, []models.FuturesProduct, error) {
+	return iter.NewIter(ctx, ListFuturesProductsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.FuturesProduct, error) {
 		res := &models.ListFuturesProductsResponse{}
-		err := fc.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := fc.CallPage(ctx, http.MethodGet, ListFuturesProductsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetFuturesProduct retrieves details for a specific futures product.
@@ -80,36 +80,36 @@ func (fc *FuturesClient) GetFuturesProduct(ctx context.Context, params *models.G
 
 // ListFuturesSchedules retrieves trading schedules for futures.
 func (fc *FuturesClient) ListFuturesSchedules(ctx context.Context, params *models.ListFuturesSchedulesParams, options ...models.RequestOption) *iter.Iter[models.FuturesSchedule] {
-	return iter.NewIter(ctx, ListFuturesSchedulesPath, params, func(uri string) (iter.ListResponse, []models.FuturesSchedule, error) {
+	return iter.NewIter(ctx, ListFuturesSchedulesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.FuturesSchedule, error) {
 		res := &models.ListFuturesSchedulesResponse{}
-		err := fc.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := fc.CallPage(ctx, http.MethodGet, ListFuturesSchedulesPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListFuturesProductSchedules retrieves trading schedules for a specific futures product.
 func (fc *FuturesClient) ListFuturesProductSchedules(ctx context.Context, params *models.ListFuturesProductSchedulesParams, options ...models.RequestOption) *iter.Iter[models.FuturesSchedule] {
-	return iter.NewIter(ctx, ListFuturesProductSchedulesPath, params, func(uri string) (iter.ListResponse, []models.FuturesSchedule, error) {
+	return iter.NewIter(ctx, ListFuturesProductSchedulesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.FuturesSchedule, error) {
 		res := &models.ListFuturesProductSchedulesResponse{}
-		err := fc.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := fc.CallPage(ctx, http.MethodGet, ListFuturesProductSchedulesPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListFuturesTrades retrieves a list of trades for a futures contract.
 func (fc *FuturesClient) ListFuturesTrades(ctx context.Context, params *models.ListFuturesTradesParams, options ...models.RequestOption) *iter.Iter[models.FuturesTrade] {
-	return iter.NewIter(ctx, ListFuturesTradesPath, params, func(uri string) (iter.ListResponse, []models.FuturesTrade, error) {
+	return iter.NewIter(ctx, ListFuturesTradesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.FuturesTrade, error) {
 		res := &models.ListFuturesTradesResponse{}
-		err := fc.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := fc.CallPage(ctx, http.MethodGet, ListFuturesTradesPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListFuturesQuotes retrieves a list of quotes for a futures contract.
 func (fc *FuturesClient) ListFuturesQuotes(ctx context.Context, params *models.ListFuturesQuotesParams, options ...models.RequestOption) *iter.Iter[models.FuturesQuote] {
-	return iter.NewIter(ctx, ListFuturesQuotesPath, params, func(uri string) (iter.ListResponse, []models.FuturesQuote, error) {
+	return iter.NewIter(ctx, ListFuturesQuotesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.FuturesQuote, error) {
 		res := &models.ListFuturesQuotesResponse{}
-		err := fc.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := fc.CallPage(ctx, http.MethodGet, ListFuturesQuotesPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
@@ -0,0 +1,5 @@
+package massive
+
+// Endpoints listed in .massive/endpoints.json are generated from the OpenAPI spec, and .massive/DRIFT.md lists
+// the paths of the spec that the client doesn't cover yet.
+//go:generate go run ../internal/cmd/restgen -spec ../.massive/rest.json -config ../.massive/endpoints.json -drift ../.massive/DRIFT.md
@@ -0,0 +1,141 @@
+package massive
+
+import (
+	"context"
+	"io"
+
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// AggsAPI defines the methods of the Massive aggs API. It's implemented by AggsClient.
+type AggsAPI interface {
+	ListAggs(ctx context.Context, params *models.ListAggsParams, options ...models.RequestOption) *iter.Iter[models.Agg]
+	ListAggsSharded(ctx context.Context, params *models.ListAggsParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Agg]
+	GetAggs(ctx context.Context, params *models.GetAggsParams, options ...models.RequestOption) (*models.GetAggsResponse, error)
+	GetGroupedDailyAggs(ctx context.Context, params *models.GetGroupedDailyAggsParams, options ...models.RequestOption) (*models.GetGroupedDailyAggsResponse, error)
+	GetDailyOpenCloseAgg(ctx context.Context, params *models.GetDailyOpenCloseAggParams, options ...models.RequestOption) (*models.GetDailyOpenCloseAggResponse, error)
+	GetCryptoDailyOpenClose(ctx context.Context, params *models.GetCryptoDailyOpenCloseParams, options ...models.RequestOption) (*models.GetCryptoDailyOpenCloseResponse, error)
+	GetPreviousCloseAgg(ctx context.Context, params *models.GetPreviousCloseAggParams, options ...models.RequestOption) (*models.GetPreviousCloseAggResponse, error)
+}
+
+// QuotesAPI defines the methods of the Massive quotes API. It's implemented by QuotesClient.
+type QuotesAPI interface {
+	ListQuotes(ctx context.Context, params *models.ListQuotesParams, options ...models.RequestOption) *iter.Iter[models.Quote]
+	ListQuotesSharded(ctx context.Context, params *models.ListQuotesParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Quote]
+	GetLastQuote(ctx context.Context, params *models.GetLastQuoteParams, options ...models.RequestOption) (*models.GetLastQuoteResponse, error)
+	GetLastForexQuote(ctx context.Context, params *models.GetLastForexQuoteParams, options ...models.RequestOption) (*models.GetLastForexQuoteResponse, error)
+	GetRealTimeCurrencyConversion(ctx context.Context, params *models.GetRealTimeCurrencyConversionParams, options ...models.RequestOption) (*models.GetRealTimeCurrencyConversionResponse, error)
+	ListHistoricQuotes(ctx context.Context, params *models.ListHistoricQuotesParams, options ...models.RequestOption) *iter.Iter[models.LastQuote]
+	ListHistoricForexTicks(ctx context.Context, params *models.ListHistoricForexTicksParams, options ...models.RequestOption) *iter.Iter[models.ForexTick]
+}
+
+// ReferenceAPI defines the methods of the Massive reference API. It's implemented by ReferenceClient.
+type ReferenceAPI interface {
+	ListTickers(ctx context.Context, params *models.ListTickersParams, options ...models.RequestOption) *iter.Iter[models.Ticker]
+	GetTickerDetails(ctx context.Context, params *models.GetTickerDetailsParams, options ...models.RequestOption) (*models.GetTickerDetailsResponse, error)
+	ListTickerNews(ctx context.Context, params *models.ListTickerNewsParams, options ...models.RequestOption) *iter.Iter[models.TickerNews]
+	GetTickerRelatedCompanies(ctx context.Context, params *models.GetTickerRelatedCompaniesParams, options ...models.RequestOption) (*models.GetTickerRelatedCompaniesResponse, error)
+	GetTickerTypes(ctx context.Context, params *models.GetTickerTypesParams, options ...models.RequestOption) (*models.GetTickerTypesResponse, error)
+	GetMarketHolidays(ctx context.Context, options ...models.RequestOption) (*models.GetMarketHolidaysResponse, error)
+	GetMarketStatus(ctx context.Context, options ...models.RequestOption) (*models.GetMarketStatusResponse, error)
+	ListSplits(ctx context.Context, params *models.ListSplitsParams, options ...models.RequestOption) *iter.Iter[models.Split]
+	ListDividends(ctx context.Context, params *models.ListDividendsParams, options ...models.RequestOption) *iter.Iter[models.Dividend]
+	ListConditions(ctx context.Context, params *models.ListConditionsParams, options ...models.RequestOption) *iter.Iter[models.Condition]
+	GetExchanges(ctx context.Context, params *models.GetExchangesParams, options ...models.RequestOption) (*models.GetExchangesResponse, error)
+	GetOptionsContract(ctx context.Context, params *models.GetOptionsContractParams, options ...models.RequestOption) (*models.GetOptionsContractResponse, error)
+	ListOptionsContracts(ctx context.Context, params *models.ListOptionsContractsParams, options ...models.RequestOption) *iter.Iter[models.OptionsContract]
+	ListShortInterest(ctx context.Context, params *models.ListShortInterestParams, options ...models.RequestOption) *iter.Iter[models.ShortInterest]
+	ListShortVolume(ctx context.Context, params *models.ListShortVolumeParams, options ...models.RequestOption) *iter.Iter[models.ShortVolume]
+	ListTreasuryYields(ctx context.Context, params *models.ListTreasuryYieldsParams, options ...models.RequestOption) *iter.Iter[models.TreasuryYield]
+	ListSECFilings(ctx context.Context, params *models.ListSECFilingsParams, options ...models.RequestOption) *iter.Iter[models.SECFiling]
+	GetSECFiling(ctx context.Context, params *models.GetSECFilingParams, options ...models.RequestOption) (*models.GetSECFilingResponse, error)
+	ListSECFilingFiles(ctx context.Context, params *models.ListSECFilingFilesParams, options ...models.RequestOption) *iter.Iter[models.SECFilingFile]
+	GetSECFilingFile(ctx context.Context, params *models.GetSECFilingFileParams, options ...models.RequestOption) (io.ReadCloser, error)
+}
+
+// TradesAPI defines the methods of the Massive trades API. It's implemented by TradesClient.
+type TradesAPI interface {
+	ListTrades(ctx context.Context, params *models.ListTradesParams, options ...models.RequestOption) *iter.Iter[models.Trade]
+	ListTradesSharded(ctx context.Context, params *models.ListTradesParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Trade]
+	GetLastTrade(ctx context.Context, params *models.GetLastTradeParams, options ...models.RequestOption) (*models.GetLastTradeResponse, error)
+	GetLastCryptoTrade(ctx context.Context, params *models.GetLastCryptoTradeParams, options ...models.RequestOption) (*models.GetLastCryptoTradeResponse, error)
+	ListHistoricTrades(ctx context.Context, params *models.ListHistoricTradesParams, options ...models.RequestOption) *iter.Iter[models.LastTrade]
+	ListHistoricCryptoTrades(ctx context.Context, params *models.ListHistoricCryptoTradesParams, options ...models.RequestOption) *iter.Iter[models.CryptoTick]
+}
+
+// SnapshotAPI defines the methods of the Massive snapshot API. It's implemented by SnapshotClient.
+type SnapshotAPI interface {
+	ListOptionsChainSnapshot(ctx context.Context, params *models.ListOptionsChainParams, options ...models.RequestOption) *iter.Iter[models.OptionContractSnapshot]
+	GetAllTickersSnapshot(ctx context.Context, params *models.GetAllTickersSnapshotParams, options ...models.RequestOption) (*models.GetAllTickersSnapshotResponse, error)
+	GetTickerSnapshot(ctx context.Context, params *models.GetTickerSnapshotParams, options ...models.RequestOption) (*models.GetTickerSnapshotResponse, error)
+	GetGainersLosersSnapshot(ctx context.Context, params *models.GetGainersLosersSnapshotParams, options ...models.RequestOption) (*models.GetGainersLosersSnapshotResponse, error)
+	GetOptionContractSnapshot(ctx context.Context, params *models.GetOptionContractSnapshotParams, options ...models.RequestOption) (*models.GetOptionContractSnapshotResponse, error)
+	GetCryptoFullBookSnapshot(ctx context.Context, params *models.GetCryptoFullBookSnapshotParams, options ...models.RequestOption) (*models.GetCryptoFullBookSnapshotResponse, error)
+	GetIndicesSnapshot(ctx context.Context, params *models.GetIndicesSnapshotParams, options ...models.RequestOption) (*models.GetIndicesSnapshotResponse, error)
+	ListAssetSnapshots(ctx context.Context, params *models.ListAssetSnapshotsParams, options ...models.RequestOption) *iter.Iter[models.SnapshotResponseModel]
+	ListUniversalSnapshots(ctx context.Context, params *models.ListUniversalSnapshotsParams, options ...models.RequestOption) *iter.Iter[models.SnapshotResponseModel]
+}
+
+// IndicatorsAPI defines the methods of the Massive technical indicators API. It's implemented by IndicatorsClient.
+type IndicatorsAPI interface {
+	GetSMA(ctx context.Context, params *models.GetSMAParams, options ...models.RequestOption) (*models.GetSMAResponse, error)
+	GetEMA(ctx context.Context, params *models.GetEMAParams, options ...models.RequestOption) (*models.GetEMAResponse, error)
+	GetMACD(ctx context.Context, params *models.GetMACDParams, options ...models.RequestOption) (*models.GetMACDResponse, error)
+	GetRSI(ctx context.Context, params *models.GetRSIParams, options ...models.RequestOption) (*models.GetRSIResponse, error)
+}
+
+// SummariesAPI defines the methods of the Massive summaries API. It's implemented by SummariesClient.
+type SummariesAPI interface {
+	GetSummaries(ctx context.Context, params *models.GetSummaryParams, options ...models.RequestOption) (*models.GetSummaryResponse, error)
+}
+
+// FuturesAPI defines the methods of the Massive futures API. It's implemented by FuturesClient.
+type FuturesAPI interface {
+	ListFuturesAggs(ctx context.Context, params *models.ListFuturesAggsParams, options ...models.RequestOption) *iter.Iter[models.FuturesAggregate]
+	ListFuturesContracts(ctx context.Context, params *models.ListFuturesContractsParams, options ...models.RequestOption) *iter.Iter[models.FuturesContract]
+	GetFuturesContract(ctx context.Context, params *models.GetFuturesContractParams, options ...models.RequestOption) (*models.GetFuturesContractResponse, error)
+	ListFuturesMarketStatuses(ctx context.Context, params *models.ListFuturesMarketStatusesParams, options ...models.RequestOption) *iter.Iter[models.FuturesMarketStatus]
+	ListFuturesProducts(ctx context.Context, params *models.ListFuturesProductsParams, options ...models.RequestOption) *iter.Iter[models.FuturesProduct]
+	GetFuturesProduct(ctx context.Context, params *models.GetFuturesProductParams, options ...models.RequestOption) (*models.GetFuturesProductResponse, error)
+	ListFuturesSchedules(ctx context.Context, params *models.ListFuturesSchedulesParams, options ...models.RequestOption) *iter.Iter[models.FuturesSchedule]
+	ListFuturesProductSchedules(ctx context.Context, params *models.ListFuturesProductSchedulesParams, options ...models.RequestOption) *iter.Iter[models.FuturesSchedule]
+	ListFuturesTrades(ctx context.Context, params *models.ListFuturesTradesParams, options ...models.RequestOption) *iter.Iter[models.FuturesTrade]
+	ListFuturesQuotes(ctx context.Context, params *models.ListFuturesQuotesParams, options ...models.RequestOption) *iter.Iter[models.FuturesQuote]
+}
+
+// VXAPI defines the methods of the Massive experimental (vX) API. It's implemented by VXClient.
+type VXAPI interface {
+	ListStockFinancials(ctx context.Context, params *models.ListStockFinancialsParams, options ...models.RequestOption) *iter.Iter[models.StockFinancial]
+	GetTickerEvents(ctx context.Context, params *models.GetTickerEventsParams, options ...models.RequestOption) (*models.GetTickerEventsResponse, error)
+	ListIPOs(ctx context.Context, params *models.ListIPOsParams, options ...models.RequestOption) *iter.Iter[models.IPOResult]
+	ListTickerTaxonomies(ctx context.Context, params *models.ListTickerTaxonomiesParams, options ...models.RequestOption) *iter.Iter[models.TickerTaxonomy]
+	GetTaxonomyIndex(ctx context.Context, params *models.ListTickerTaxonomiesParams, options ...models.RequestOption) (*models.TaxonomyIndex, error)
+}
+
+// API defines the methods of the Massive REST API that Client provides, so that code can depend on an interface
+// and substitute fakes (e.g. massivetest.FakeClient) or decorators. The experimental VX methods are accessed through
+// the VX field of Client and are defined by VXAPI.
+type API interface {
+	AggsAPI
+	QuotesAPI
+	ReferenceAPI
+	TradesAPI
+	SnapshotAPI
+	IndicatorsAPI
+	SummariesAPI
+	FuturesAPI
+}
+
+var (
+	_ API           = (*Client)(nil)
+	_ AggsAPI       = (*AggsClient)(nil)
+	_ QuotesAPI     = (*QuotesClient)(nil)
+	_ ReferenceAPI  = (*ReferenceClient)(nil)
+	_ TradesAPI     = (*TradesClient)(nil)
+	_ SnapshotAPI   = (*SnapshotClient)(nil)
+	_ IndicatorsAPI = (*IndicatorsClient)(nil)
+	_ SummariesAPI  = (*SummariesClient)(nil)
+	_ FuturesAPI    = (*FuturesClient)(nil)
+	_ VXAPI         = (*VXClient)(nil)
+)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/massive-com/client-go/v2/rest/encoder"
+	"github.com/massive-com/client-go/v2/rest/models"
 )
 
 // ListResponse defines an interface that list API responses must implement.
@@ -13,8 +14,9 @@ type ListResponse interface {
 }
 
 // Query defines a closure that domain specific iterators must implement. The implementation should
-// include a call to the API and should return the API response with a separate slice of the results.
-type Query[T any] func(string) (ListResponse, []T, error)
+// include a call to the API made with the given context and should return the API response with a separate
+// slice of the results. The context of pages that are fetched in the background is canceled by Iter.Close.
+type Query[T any] func(ctx context.Context, uri string) (ListResponse, []T, error)
 
 // Iter defines an iterator type that list methods should return. The contained type should typically
 // be a model that's returned in the results of a list method response.
@@ -26,51 +28,191 @@ type Iter[T any] struct {
 	item    T
 	results []T
 
+	// uri is the request URI of the current page and offset is the number of its results that were consumed
+	uri     string
+	offset  int
+	sharded bool
+
+	// delivered is set once the current page was returned by NextPage
+	delivered bool
+	pageCount int
+	itemCount int
+
+	pages  chan page[T]
+	cancel context.CancelFunc
+
 	err error
 }
 
+// page is a page of results fetched in the background.
+type page[T any] struct {
+	uri     string
+	res     ListResponse
+	results []T
+	err     error
+}
+
 // NewIter returns a new initialized iterator. This method automatically makes the first query to populate
-// the results. List methods should use this helper method when building domain specific iterators.
-func NewIter[T any](ctx context.Context, path string, params any, query Query[T]) *Iter[T] {
+// the results. List methods should use this helper method when building domain specific iterators and pass
+// on their request options so that iterator settings (e.g. models.Prefetch or models.ResumeFrom) are applied.
+func NewIter[T any](ctx context.Context, path string, params any, query Query[T], opts ...models.RequestOption) *Iter[T] {
+	options := mergeOptions(opts...)
+	if options.Resume != nil {
+		return newIter(ctx, *options.Resume, query, options)
+	}
+
+	uri, err := encoder.New().EncodeParams(path, params)
+	if err != nil {
+		return &Iter[T]{ctx: ctx, query: query, err: err}
+	}
+
+	return newIter(ctx, models.Cursor{URL: uri}, query, options)
+}
+
+// Resume returns an iterator that continues from a cursor returned by the Cursor method of an earlier iterator.
+// Like NewIter, it automatically makes the first query to populate the results.
+func Resume[T any](ctx context.Context, cursor models.Cursor, query Query[T], opts ...models.RequestOption) *Iter[T] {
+	return newIter(ctx, cursor, query, mergeOptions(opts...))
+}
+
+func newIter[T any](ctx context.Context, cursor models.Cursor, query Query[T], options *models.RequestOptions) *Iter[T] {
 	it := Iter[T]{
 		ctx:   ctx,
 		query: query,
 	}
 
-	uri, err := encoder.New().EncodeParams(path, params)
-	if err != nil {
-		it.err = err
+	if cursor.URL == "" {
 		return &it
 	}
 
-	it.page, it.results, it.err = it.query(uri)
+	it.load(cursor.URL)
+	offset := min(max(cursor.Offset, 0), len(it.results))
+	it.results = it.results[offset:]
+	it.offset = offset
+
+	if options.Prefetch > 0 && it.err == nil && it.page.NextPage() != "" {
+		it.prefetch(options.Prefetch)
+	}
+
 	return &it
 }
 
+// load replaces the current page with the page at uri.
+func (it *Iter[T]) load(uri string) {
+	it.uri, it.offset, it.delivered = uri, 0, false
+	it.page, it.results, it.err = it.query(it.ctx, uri)
+	if it.err == nil {
+		it.pageCount++
+	}
+}
+
+// prefetch starts fetching the following pages in the background. Up to n pages are fetched ahead of the
+// page that's being consumed. Pages are delivered in order and fetching stops after the first error.
+func (it *Iter[T]) prefetch(n int) {
+	ctx, cancel := context.WithCancel(it.ctx)
+	it.cancel = cancel
+	it.pages = make(chan page[T], n-1)
+
+	next := it.page.NextPage()
+	go func() {
+		defer close(it.pages)
+		for next != "" {
+			res, results, err := it.query(ctx, next)
+			select {
+			case it.pages <- page[T]{uri: next, res: res, results: results, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+			next = res.NextPage()
+		}
+	}()
+}
+
 // Next moves the iterator to the next result.
 func (it *Iter[T]) Next() bool {
 	if it.err != nil {
 		return false
 	}
 
-	if len(it.results) == 0 && it.page.NextPage() != "" {
-		it.page, it.results, it.err = it.query(it.page.NextPage())
+	if len(it.results) == 0 {
+		it.nextPage()
 	}
 
 	if it.err != nil || len(it.results) == 0 {
+		it.Close()
 		return false
 	}
 
 	it.err = it.ctx.Err()
 	if it.err != nil {
+		it.Close()
 		return false
 	}
 
 	it.item = it.results[0]
 	it.results = it.results[1:]
+	it.offset++
+	it.itemCount++
 	return true
 }
 
+// nextPage replaces the drained results with the next page, either from the prefetched pages or by querying
+// the API directly. It reports whether there was a next page.
+func (it *Iter[T]) nextPage() bool {
+	if it.pages == nil {
+		if it.page == nil || it.page.NextPage() == "" {
+			return false
+		}
+		it.load(it.page.NextPage())
+		return true
+	}
+
+	select {
+	case p, ok := <-it.pages:
+		if !ok {
+			return false
+		}
+		it.uri, it.offset, it.delivered = p.uri, 0, false
+		it.page, it.results, it.err = p.res, p.results, p.err
+		if it.err == nil {
+			it.pageCount++
+		}
+		return true
+	case <-it.ctx.Done():
+		it.err = it.ctx.Err()
+		return false
+	}
+}
+
+// Close stops fetching pages in the background and aborts the request of a page that's being fetched. It's only
+// needed when prefetching is enabled and the caller stops iterating before the iterator is exhausted without
+// canceling its context.
+func (it *Iter[T]) Close() {
+	if it.cancel != nil {
+		it.cancel()
+	}
+}
+
+// Cursor returns the position of the next result so that iteration can be resumed later, e.g. by passing it to
+// a list method with models.ResumeFrom. If the iterator stopped because of an error, the cursor points at the
+// first result that wasn't returned, so resuming retries the failed request. The cursor's URL is empty once all
+// results were read. Sharded iterators can't be resumed and always return an empty cursor.
+func (it *Iter[T]) Cursor() models.Cursor {
+	if it.sharded {
+		return models.Cursor{}
+	}
+	if len(it.results) > 0 || it.err != nil {
+		return models.Cursor{URL: it.uri, Offset: it.offset}
+	}
+	if it.page == nil {
+		return models.Cursor{}
+	}
+	return models.Cursor{URL: it.page.NextPage()}
+}
+
 // Item returns the result that the iterator is currently pointing to.
 func (it *Iter[T]) Item() T {
 	return it.item
@@ -80,3 +222,12 @@ func (it *Iter[T]) Item() T {
 func (it *Iter[T]) Err() error {
 	return it.err
 }
+
+func mergeOptions(opts ...models.RequestOption) *models.RequestOptions {
+	options := &models.RequestOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	return options
+}
@@ -3,8 +3,12 @@ package iter_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -38,11 +42,11 @@ type ListResourceParams struct {
 }
 
 func (c *Client) ListResource(ctx context.Context, params *ListResourceParams, options ...models.RequestOption) *iter.Iter[Resource] {
-	return iter.NewIter(ctx, listResourcePath, params, func(uri string) (iter.ListResponse, []Resource, error) {
+	return iter.NewIter(ctx, listResourcePath, params, func(ctx context.Context, uri string) (iter.ListResponse, []Resource, error) {
 		res := &ListResourceResponse{}
 		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 func TestListResource(t *testing.T) {
@@ -166,6 +170,135 @@ func TestListResourceEncodeError(t *testing.T) {
 	assert.NotNil(t, it.Item())
 }
 
+func TestListResourcePrefetch(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerPages(5)
+
+	it := c.ListResource(context.Background(), &ListResourceParams{
+		Ticker: "ticker1",
+	}, models.Prefetch(2))
+
+	// the following pages are fetched without consuming the first one
+	assert.Eventually(t, func() bool { return httpmock.GetTotalCallCount() == 3 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+
+	var prices []string
+	for it.Next() {
+		prices = append(prices, it.Item().Price)
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"price0", "price1", "price2", "price3", "price4"}, prices)
+	assert.Equal(t, 5, httpmock.GetTotalCallCount())
+}
+
+func TestListResourcePrefetchError(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerPages(3)
+	registerResponder(404, "https://api.massive.com/resource/ticker1?cursor=2", ListResourceResponse{
+		BaseResponse: models.BaseResponse{Status: "NOT FOUND", ErrorMessage: "resource not found"},
+	})
+
+	it := c.ListResource(context.Background(), &ListResourceParams{
+		Ticker: "ticker1",
+	}, models.Prefetch(3))
+
+	// results before the failed page are returned before the error
+	var prices []string
+	for it.Next() {
+		prices = append(prices, it.Item().Price)
+	}
+	assert.Equal(t, []string{"price0", "price1"}, prices)
+	assert.ErrorIs(t, it.Err(), models.ErrNotFound)
+}
+
+func TestListResourcePrefetchCanceled(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerPages(10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := c.ListResource(ctx, &ListResourceParams{
+		Ticker: "ticker1",
+	}, models.Prefetch(1))
+
+	assert.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+
+	// the background fetching stops instead of reading the remaining pages
+	time.Sleep(10 * time.Millisecond)
+	assert.Less(t, httpmock.GetTotalCallCount(), 10)
+}
+
+func TestListResourcePrefetchClose(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerResponder(200, "https://api.massive.com/resource/ticker1", ListResourceResponse{
+		BaseResponse: models.BaseResponse{Status: "OK", Count: 1, PaginationHooks: models.PaginationHooks{
+			NextURL: "https://api.massive.com/resource/ticker1?cursor=1",
+		}},
+		Results: []Resource{{Price: "price0"}},
+	})
+	started := make(chan struct{})
+	aborted := make(chan struct{})
+	httpmock.RegisterResponder("GET", "https://api.massive.com/resource/ticker1?cursor=1",
+		func(req *http.Request) (*http.Response, error) {
+			close(started)
+			select {
+			case <-req.Context().Done():
+				close(aborted)
+				return nil, req.Context().Err()
+			case <-time.After(time.Second):
+				return nil, errors.New("request wasn't aborted")
+			}
+		},
+	)
+
+	it := c.ListResource(context.Background(), &ListResourceParams{
+		Ticker: "ticker1",
+	}, models.Prefetch(1))
+	assert.True(t, it.Next())
+	<-started
+
+	// closing the iterator aborts the request of the page that's being fetched
+	it.Close()
+	select {
+	case <-aborted:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("the prefetched page's request wasn't aborted")
+	}
+}
+
+// registerPages registers n pages of one result each that link to each other via next URLs.
+func registerPages(n int) {
+	for i := 0; i < n; i++ {
+		url := "https://api.massive.com/resource/ticker1"
+		if i > 0 {
+			url += fmt.Sprintf("?cursor=%d", i)
+		}
+		res := ListResourceResponse{
+			BaseResponse: models.BaseResponse{Status: "OK", Count: 1},
+			Results:      []Resource{{Price: fmt.Sprintf("price%d", i)}},
+		}
+		if i < n-1 {
+			res.NextURL = fmt.Sprintf("https://api.massive.com/resource/ticker1?cursor=%d", i+1)
+		}
+		registerResponder(200, url, res)
+	}
+}
+
 func registerResponder(status int, url string, res ListResourceResponse) {
 	httpmock.RegisterResponder("GET", url,
 		func(req *http.Request) (*http.Response, error) {
@@ -179,3 +312,218 @@ func registerResponder(status int, url string, res ListResourceResponse) {
 		},
 	)
 }
+
+func TestCursor(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder(200, "https://api.massive.com/resource/ticker1", ListResourceResponse{
+		BaseResponse: models.BaseResponse{
+			Status: "OK",
+			PaginationHooks: models.PaginationHooks{
+				NextURL: "https://api.massive.com/resource/ticker1?cursor=NEXT",
+			},
+		},
+		Results: []Resource{{Price: "price1"}, {Price: "price2"}},
+	})
+	registerResponder(404, "https://api.massive.com/resource/ticker1?cursor=NEXT", ListResourceResponse{
+		BaseResponse: models.BaseResponse{Status: "NOT FOUND", ErrorMessage: "resource not found"},
+	})
+
+	it := c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"})
+	assert.True(t, it.Next())
+	assert.Equal(t, models.Cursor{URL: "/resource/ticker1", Offset: 1}, it.Cursor())
+
+	// the cursor survives serialization and resumes after the last result that was read
+	b, err := json.Marshal(it.Cursor())
+	assert.Nil(t, err)
+	var cursor models.Cursor
+	assert.Nil(t, json.Unmarshal(b, &cursor))
+
+	resumed := c.ListResource(context.Background(), nil, models.ResumeFrom(cursor))
+	assert.True(t, resumed.Next())
+	assert.Equal(t, "price2", resumed.Item().Price)
+
+	// a failed page is retried when resuming
+	assert.False(t, resumed.Next())
+	assert.NotNil(t, resumed.Err())
+	cursor = resumed.Cursor()
+	assert.Equal(t, models.Cursor{URL: "https://api.massive.com/resource/ticker1?cursor=NEXT"}, cursor)
+
+	registerResponder(200, "https://api.massive.com/resource/ticker1?cursor=NEXT", ListResourceResponse{
+		BaseResponse: models.BaseResponse{Status: "OK"},
+		Results:      []Resource{{Price: "price3"}},
+	})
+	resumed = iter.Resume(context.Background(), cursor, func(ctx context.Context, uri string) (iter.ListResponse, []Resource, error) {
+		res := &ListResourceResponse{}
+		err := c.CallURL(ctx, http.MethodGet, uri, res)
+		return res, res.Results, err
+	})
+	assert.True(t, resumed.Next())
+	assert.Equal(t, "price3", resumed.Item().Price)
+	assert.False(t, resumed.Next())
+	assert.Nil(t, resumed.Err())
+
+	// the cursor of a finished iterator is empty, and resuming from it yields no results
+	assert.Equal(t, models.Cursor{}, resumed.Cursor())
+	assert.False(t, c.ListResource(context.Background(), nil, models.ResumeFrom(resumed.Cursor())).Next())
+}
+
+func TestCursorPrefetch(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerPages(4)
+
+	it := c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"}, models.Prefetch(2))
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.Equal(t, models.Cursor{URL: "https://api.massive.com/resource/ticker1?cursor=2"}, it.Cursor())
+	it.Close()
+
+	var prices []string
+	for r, err := range c.ListResource(context.Background(), nil, models.ResumeFrom(it.Cursor())).All() {
+		assert.Nil(t, err)
+		prices = append(prices, r.Price)
+	}
+	assert.Equal(t, []string{"price2", "price3"}, prices)
+}
+
+func TestNextPage(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder(200, "https://api.massive.com/resource/ticker1", ListResourceResponse{
+		BaseResponse: models.BaseResponse{
+			Status:    "OK",
+			RequestID: "req1",
+			Count:     2,
+			PaginationHooks: models.PaginationHooks{
+				NextURL: "https://api.massive.com/resource/ticker1?cursor=NEXT",
+			},
+		},
+		Results: []Resource{{Price: "price1"}, {Price: "price2"}},
+	})
+	registerResponder(200, "https://api.massive.com/resource/ticker1?cursor=NEXT", ListResourceResponse{
+		BaseResponse: models.BaseResponse{Status: "OK", RequestID: "req2", Count: 1},
+		Results:      []Resource{{Price: "price3"}},
+	})
+
+	it := c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"})
+	page, err := it.NextPage()
+	assert.Nil(t, err)
+	assert.Equal(t, "/resource/ticker1", page.URL)
+	assert.Equal(t, "req1", page.RequestID)
+	assert.Equal(t, "OK", page.Status)
+	assert.Equal(t, 2, page.Count)
+	assert.Equal(t, "https://api.massive.com/resource/ticker1?cursor=NEXT", page.NextURL)
+	assert.Equal(t, []Resource{{Price: "price1"}, {Price: "price2"}}, page.Results)
+	assert.Equal(t, 1, page.Number)
+	assert.Equal(t, 2, page.Items)
+
+	page, err = it.NextPage()
+	assert.Nil(t, err)
+	assert.Equal(t, "req2", page.RequestID)
+	assert.Equal(t, []Resource{{Price: "price3"}}, page.Results)
+	assert.Equal(t, 2, page.Number)
+	assert.Equal(t, 3, page.Items)
+
+	_, err = it.NextPage()
+	assert.ErrorIs(t, err, iter.ErrDone)
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+	assert.Equal(t, 2, it.PageCount())
+	assert.Equal(t, 3, it.ItemCount())
+}
+
+func TestNextPageMixedWithNext(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder(200, "https://api.massive.com/resource/ticker1", ListResourceResponse{
+		BaseResponse: models.BaseResponse{
+			Status: "OK",
+			PaginationHooks: models.PaginationHooks{
+				NextURL: "https://api.massive.com/resource/ticker1?cursor=NEXT",
+			},
+		},
+		Results: []Resource{{Price: "price1"}, {Price: "price2"}},
+	})
+	registerResponder(404, "https://api.massive.com/resource/ticker1?cursor=NEXT", ListResourceResponse{
+		BaseResponse: models.BaseResponse{Status: "NOT FOUND", ErrorMessage: "resource not found"},
+	})
+
+	it := c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"})
+	assert.True(t, it.Next())
+	assert.Equal(t, "price1", it.Item().Price)
+
+	// the rest of the current page is returned first
+	page, err := it.NextPage()
+	assert.Nil(t, err)
+	assert.Equal(t, []Resource{{Price: "price2"}}, page.Results)
+	assert.Equal(t, 2, page.Items)
+
+	_, err = it.NextPage()
+	assert.ErrorIs(t, err, models.ErrNotFound)
+	assert.ErrorIs(t, it.Err(), models.ErrNotFound)
+}
+
+func TestOffsetPage(t *testing.T) {
+	timestamp := func(r Resource) int64 {
+		ts, _ := strconv.ParseInt(r.Price, 10, 64)
+		return ts
+	}
+	resources := func(timestamps ...string) []Resource {
+		var res []Resource
+		for _, ts := range timestamps {
+			res = append(res, Resource{Price: ts})
+		}
+		return res
+	}
+
+	// a short page is the last one
+	next, page, err := iter.OffsetPage("/v1/historic/forex/AUD/USD/2020-10-14?limit=3", "offset", 10000, resources("1", "2"), timestamp)
+	assert.Nil(t, err)
+	assert.Equal(t, "", next)
+	assert.Equal(t, resources("1", "2"), page)
+
+	// the results at the last timestamp of a full page are left for the next page, which starts at it
+	next, page, err = iter.OffsetPage("/v1/historic/forex/AUD/USD/2020-10-14?limit=3", "offset", 10000, resources("1", "2", "2"), timestamp)
+	assert.Nil(t, err)
+	assert.Equal(t, "/v1/historic/forex/AUD/USD/2020-10-14?limit=3&offset=2", next)
+	assert.Equal(t, resources("1"), page)
+
+	// the default limit applies if the URI doesn't set one
+	next, page, err = iter.OffsetPage("/v2/ticks/stocks/trades/AAPL/2020-10-14?timestamp=1", "timestamp", 2, resources("1", "2"), timestamp)
+	assert.Nil(t, err)
+	assert.Equal(t, "/v2/ticks/stocks/trades/AAPL/2020-10-14?timestamp=2", next)
+	assert.Equal(t, resources("1"), page)
+
+	next, page, err = iter.OffsetPage("/v2/ticks/stocks/trades/AAPL/2020-10-14", "timestamp", 5000, nil, timestamp)
+	assert.Nil(t, err)
+	assert.Equal(t, "", next)
+	assert.Empty(t, page)
+
+	// reversed results descend and the next page continues backwards from the last timestamp
+	next, page, err = iter.OffsetPage("/v2/ticks/stocks/nbbo/AAPL/2020-10-14?limit=3&reverse=true", "timestamp", 5000, resources("3", "2", "2"), timestamp)
+	assert.Nil(t, err)
+	assert.Equal(t, "/v2/ticks/stocks/nbbo/AAPL/2020-10-14?limit=3&reverse=true&timestamp=2", next)
+	assert.Equal(t, resources("3"), page)
+
+	// the offset can't be computed from results that aren't in the requested order
+	_, _, err = iter.OffsetPage("/v2/ticks/stocks/nbbo/AAPL/2020-10-14?limit=3", "timestamp", 5000, resources("3", "2", "1"), timestamp)
+	assert.ErrorContains(t, err, "isn't in the requested order")
+	_, _, err = iter.OffsetPage("/v2/ticks/stocks/nbbo/AAPL/2020-10-14?limit=3&reverse=true", "timestamp", 5000, resources("1", "2", "3"), timestamp)
+	assert.ErrorContains(t, err, "isn't in the requested order")
+
+	// there's no way to page past a full page of results with the same timestamp
+	_, _, err = iter.OffsetPage("/v1/historic/forex/AUD/USD/2020-10-14?limit=2&offset=2", "offset", 10000, resources("2", "2"), timestamp)
+	assert.ErrorContains(t, err, "all 2 results of the page have the timestamp 2")
+}
@@ -0,0 +1,68 @@
+package iter
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// OffsetPage pages through the results of legacy endpoints that paginate with a timestamp offset query parameter
+// rather than next URLs. The offset is inclusive: a page starts with the results at the offset timestamp, so using
+// the timestamp of the last result of a page as the offset would return the results at that timestamp again. Query
+// implementations should pass the results of the page at uri through it and set the URI it returns as the next page
+// of their response:
+//
+//	next, ticks, err := iter.OffsetPage(uri, "offset", limit, res.Ticks, func(t models.CryptoTick) int64 {
+//		return time.Time(t.Timestamp).UnixMilli()
+//	})
+//	res.NextURL = next
+//
+// A page that's shorter than its limit (the "limit" param of uri, or defaultLimit if it isn't set) is the last one.
+// Otherwise, the results at the timestamp of the last result are left for the next page, which starts at that
+// timestamp, so that they're neither skipped nor repeated. Results are in ascending timestamp order unless the
+// "reverse" param of uri is true, in which case they're descending and the next page continues backwards from the
+// offset. It returns an error if the results of a page aren't in the requested order, or if all results of a full
+// page have the same timestamp since the results after them can't be requested.
+func OffsetPage[T any](uri, param string, defaultLimit int, results []T, timestamp func(T) int64) (string, []T, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", nil, err
+	}
+
+	q := u.Query()
+	limit := defaultLimit
+	if s := q.Get("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil {
+			return "", nil, fmt.Errorf("invalid limit %q: %w", s, err)
+		}
+	}
+	reverse := false
+	if s := q.Get("reverse"); s != "" {
+		if reverse, err = strconv.ParseBool(s); err != nil {
+			return "", nil, fmt.Errorf("invalid reverse %q: %w", s, err)
+		}
+	}
+	for i := 1; i < len(results); i++ {
+		prev, ts := timestamp(results[i-1]), timestamp(results[i])
+		if (!reverse && ts < prev) || (reverse && ts > prev) {
+			return "", nil, fmt.Errorf("result %d of the page has the timestamp %d after %d, which isn't in the requested order", i, ts, prev)
+		}
+	}
+
+	if len(results) == 0 || len(results) < limit {
+		return "", results, nil
+	}
+
+	offset := timestamp(results[len(results)-1])
+	i := len(results) - 1
+	for i > 0 && timestamp(results[i-1]) == offset {
+		i--
+	}
+	if i == 0 {
+		return "", nil, fmt.Errorf("all %d results of the page have the timestamp %d, use a higher limit to page past them", len(results), offset)
+	}
+
+	q.Set(param, strconv.FormatInt(offset, 10))
+	u.RawQuery = q.Encode()
+	return u.String(), results[:i], nil
+}
@@ -0,0 +1,94 @@
+package iter
+
+import (
+	"errors"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// ErrDone is returned by NextPage when there are no more pages.
+var ErrDone = errors.New("no more pages")
+
+// Page is a page of list results along with the attributes of the response that it came from.
+type Page[T any] struct {
+	models.BaseResponse
+
+	// URL is the request URI of the page.
+	URL string
+
+	// Results are the results of the page. If some of them were already read with Next, only the remaining
+	// ones are included.
+	Results []T
+
+	// Number is the position of the page, counting from 1 (or from the page that the iterator was resumed from).
+	Number int
+
+	// Items is the number of results read so far, including the ones of this page.
+	Items int
+}
+
+// NextPage returns the next page of results. It returns ErrDone once all pages were read, or the error that
+// stopped the iteration. It can be mixed with Next, in which case it returns the remaining results of the
+// current page first.
+//
+//	for {
+//		page, err := iter.NextPage()
+//		if errors.Is(err, iter.ErrDone) {
+//			break
+//		} else if err != nil {
+//			return err
+//		}
+//		log.Print(page.RequestID, len(page.Results)) // do something with the current page
+//	}
+func (it *Iter[T]) NextPage() (Page[T], error) {
+	if it.err != nil {
+		return Page[T]{}, it.err
+	}
+
+	// the current page is returned unless it was already returned or read to the end with Next
+	if it.page == nil || it.delivered || (len(it.results) == 0 && it.offset > 0) {
+		if !it.nextPage() {
+			if it.err != nil {
+				return Page[T]{}, it.err
+			}
+			it.Close()
+			return Page[T]{}, ErrDone
+		}
+		if it.err != nil {
+			return Page[T]{}, it.err
+		}
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		it.Close()
+		return Page[T]{}, err
+	}
+
+	results := it.results
+	it.results = nil
+	it.offset += len(results)
+	it.itemCount += len(results)
+	it.delivered = true
+
+	page := Page[T]{
+		URL:     it.uri,
+		Results: results,
+		Number:  it.pageCount,
+		Items:   it.itemCount,
+	}
+	if res, ok := it.page.(interface{ Base() models.BaseResponse }); ok {
+		page.BaseResponse = res.Base()
+	}
+	return page, nil
+}
+
+// PageCount returns the number of pages that the iterator has moved to so far, including the current one.
+func (it *Iter[T]) PageCount() int {
+	return it.pageCount
+}
+
+// ItemCount returns the number of results read so far.
+func (it *Iter[T]) ItemCount() int {
+	return it.itemCount
+}
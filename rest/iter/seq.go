@@ -0,0 +1,143 @@
+package iter
+
+import (
+	goiter "iter"
+)
+
+// All returns a sequence of the remaining results for use with range-over-func loops. Iteration errors are
+// yielded once, with the zero value, after the results that came before them. Breaking out of the loop stops
+// any background fetching.
+//
+//	for trade, err := range c.ListTrades(ctx, params).All() {
+//		if err != nil {
+//			return err
+//		}
+//		log.Print(trade) // do something with the current value
+//	}
+func (it *Iter[T]) All() goiter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer it.Close()
+		for it.Next() {
+			if !yield(it.Item(), nil) {
+				return
+			}
+		}
+		if it.Err() != nil {
+			var zero T
+			yield(zero, it.Err())
+		}
+	}
+}
+
+// Seq returns a sequence of the remaining results for use with range-over-func loops. Unlike All, it doesn't
+// yield errors, so Err should be checked once the loop is done.
+//
+//	for trade := range iter.Seq() {
+//		log.Print(trade) // do something with the current value
+//	}
+//	if iter.Err() != nil {
+//		return iter.Err()
+//	}
+func (it *Iter[T]) Seq() goiter.Seq[T] {
+	return func(yield func(T) bool) {
+		defer it.Close()
+		for it.Next() {
+			if !yield(it.Item()) {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns all results of a sequence. It stops at the first error and returns the results up to it.
+func Collect[T any](seq goiter.Seq2[T, error]) ([]T, error) {
+	var res []T
+	for item, err := range seq {
+		if err != nil {
+			return res, err
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// Take returns a sequence of the first n results of a sequence. Any error is passed on.
+func Take[T any](seq goiter.Seq2[T, error], n int) goiter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for item, err := range seq {
+			if !yield(item, err) || err != nil {
+				return
+			}
+			if taken++; taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence of the results of a sequence that keep returns true for. Any error is passed on.
+func Filter[T any](seq goiter.Seq2[T, error], keep func(T) bool) goiter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for item, err := range seq {
+			if err != nil || keep(item) {
+				if !yield(item, err) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Map returns a sequence of the results of a sequence transformed by f. Any error is passed on with the zero
+// value of the new type.
+func Map[T, U any](seq goiter.Seq2[T, error], f func(T) U) goiter.Seq2[U, error] {
+	return func(yield func(U, error) bool) {
+		for item, err := range seq {
+			if err != nil {
+				var zero U
+				yield(zero, err)
+				return
+			}
+			if !yield(f(item), nil) {
+				return
+			}
+		}
+	}
+}
+
+// Batch returns a sequence of slices of up to size results of a sequence. The last batch may be smaller. If an
+// error occurs, the partial batch before it is yielded first and the error is yielded with a nil batch.
+func Batch[T any](seq goiter.Seq2[T, error], size int) goiter.Seq2[[]T, error] {
+	if size < 1 {
+		size = 1
+	}
+
+	return func(yield func([]T, error) bool) {
+		batch := make([]T, 0, size)
+		for item, err := range seq {
+			if err != nil {
+				if len(batch) > 0 && !yield(batch, nil) {
+					return
+				}
+				yield(nil, err)
+				return
+			}
+
+			batch = append(batch, item)
+			if len(batch) == size {
+				if !yield(batch, nil) {
+					return
+				}
+				batch = make([]T, 0, size)
+			}
+		}
+		if len(batch) > 0 {
+			yield(batch, nil)
+		}
+	}
+}
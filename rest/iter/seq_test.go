@@ -0,0 +1,110 @@
+package iter_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/massive-com/client-go/v2/rest/client"
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+func TestAll(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerPages(3)
+
+	var prices []string
+	for r, err := range c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"}).All() {
+		assert.Nil(t, err)
+		prices = append(prices, r.Price)
+	}
+	assert.Equal(t, []string{"price0", "price1", "price2"}, prices)
+
+	// breaking out of the loop stops fetching pages
+	httpmock.ZeroCallCounters()
+	for range c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"}).All() {
+		break
+	}
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestAllError(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerPages(3)
+	registerResponder(404, "https://api.massive.com/resource/ticker1?cursor=2", ListResourceResponse{
+		BaseResponse: models.BaseResponse{Status: "NOT FOUND", ErrorMessage: "resource not found"},
+	})
+
+	res, err := iter.Collect(c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"}).All())
+	assert.ErrorIs(t, err, models.ErrNotFound)
+	assert.Equal(t, []Resource{{Price: "price0"}, {Price: "price1"}}, res)
+
+	// Seq doesn't yield the error, so it's checked afterwards
+	it := c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"})
+	var prices []string
+	for r := range it.Seq() {
+		prices = append(prices, r.Price)
+	}
+	assert.Equal(t, []string{"price0", "price1"}, prices)
+	assert.ErrorIs(t, it.Err(), models.ErrNotFound)
+}
+
+func TestAdapters(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerPages(10)
+
+	all := func() *iter.Iter[Resource] {
+		return c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"})
+	}
+	index := func(r Resource) int {
+		i, _ := strconv.Atoi(strings.TrimPrefix(r.Price, "price"))
+		return i
+	}
+
+	res, err := iter.Collect(iter.Take(all().All(), 3))
+	assert.Nil(t, err)
+	assert.Equal(t, []Resource{{Price: "price0"}, {Price: "price1"}, {Price: "price2"}}, res)
+
+	even, err := iter.Collect(iter.Map(iter.Filter(all().All(), func(r Resource) bool { return index(r)%2 == 0 }), index))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2, 4, 6, 8}, even)
+
+	batches, err := iter.Collect(iter.Batch(iter.Map(all().All(), index), 4))
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}, {8, 9}}, batches)
+}
+
+func TestBatchError(t *testing.T) {
+	c := Client{Client: client.New("API_KEY")}
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+	registerPages(3)
+	registerResponder(404, "https://api.massive.com/resource/ticker1?cursor=2", ListResourceResponse{
+		BaseResponse: models.BaseResponse{Status: "NOT FOUND", ErrorMessage: "resource not found"},
+	})
+
+	var batches [][]Resource
+	var errs []error
+	for batch, err := range iter.Batch(c.ListResource(context.Background(), &ListResourceParams{Ticker: "ticker1"}).All(), 5) {
+		batches = append(batches, batch)
+		errs = append(errs, err)
+	}
+	assert.Equal(t, [][]Resource{{{Price: "price0"}, {Price: "price1"}}, nil}, batches)
+	assert.Nil(t, errs[0])
+	assert.ErrorIs(t, errs[1], models.ErrNotFound)
+}
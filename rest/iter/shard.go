@@ -0,0 +1,118 @@
+package iter
+
+import (
+	"context"
+
+	"github.com/massive-com/client-go/v2/rest/encoder"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// Shardable defines an interface that params must implement to be fetched by a sharded iterator.
+type Shardable interface {
+	// Shard splits the params into up to n params that cover consecutive parts of the original query. The
+	// shards must be returned in the order that their results should be returned in.
+	Shard(n int) ([]any, error)
+}
+
+// NewShardedIter returns an iterator that splits a list query into shards and fetches them concurrently, which speeds
+// up queries over long ranges that a single iterator would fetch one page at a time. At most workers shards are
+// fetched at a time, each buffering up to one page ahead of the consumer (or the number of pages set with
+// models.Prefetch). Results are returned shard by shard, so shards that cover consecutive time ranges yield results
+// in timestamp order. An error stops the iteration after the results that came before it.
+func NewShardedIter[T any](ctx context.Context, path string, params Shardable, shards, workers int, query Query[T], opts ...models.RequestOption) *Iter[T] {
+	it := Iter[T]{
+		ctx:     ctx,
+		query:   query,
+		sharded: true,
+	}
+
+	parts, err := params.Shard(shards)
+	if err != nil {
+		it.err = err
+		return &it
+	}
+
+	uris := make([]string, len(parts))
+	enc := encoder.New()
+	for i, p := range parts {
+		if uris[i], err = enc.EncodeParams(path, p); err != nil {
+			it.err = err
+			return &it
+		}
+	}
+
+	options := mergeOptions(opts...)
+	it.shard(uris, max(workers, 1), max(options.Prefetch, 1))
+	return &it
+}
+
+// shard starts fetching the shards in the background and merges their pages, in shard order, into the pages
+// that the iterator consumes.
+func (it *Iter[T]) shard(uris []string, workers, prefetch int) {
+	ctx, cancel := context.WithCancel(it.ctx)
+	it.cancel = cancel
+	it.pages = make(chan page[T])
+
+	// each shard streams its pages into its own buffered channel and holds a worker slot until it's done
+	shards := make([]chan page[T], len(uris))
+	for i := range shards {
+		shards[i] = make(chan page[T], prefetch)
+	}
+	slots := make(chan struct{}, workers)
+	go func() {
+		for i, uri := range uris {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(out chan<- page[T], next string) {
+				defer func() { <-slots }()
+				defer close(out)
+				for next != "" {
+					res, results, err := it.query(ctx, next)
+					select {
+					case out <- page[T]{uri: next, res: res, results: results, err: err}:
+					case <-ctx.Done():
+						return
+					}
+					if err != nil {
+						return
+					}
+					next = res.NextPage()
+				}
+			}(shards[i], uri)
+		}
+	}()
+
+	go func() {
+		defer close(it.pages)
+		for _, pages := range shards {
+			for {
+				var p page[T]
+				var ok bool
+				select {
+				case p, ok = <-pages:
+				case <-ctx.Done():
+					return
+				}
+				if !ok {
+					break
+				}
+
+				// empty pages would end the iteration, so only pages with results or an error are passed on
+				if len(p.results) == 0 && p.err == nil {
+					continue
+				}
+				select {
+				case it.pages <- p:
+				case <-ctx.Done():
+					return
+				}
+				if p.err != nil {
+					return
+				}
+			}
+		}
+	}()
+}
@@ -184,6 +184,39 @@ type GetDailyOpenCloseAggResponse struct {
 	OTC        bool    `json:"otc,omitempty"`
 }
 
+// GetCryptoDailyOpenCloseParams is the set of parameters for the GetCryptoDailyOpenClose method.
+type GetCryptoDailyOpenCloseParams struct {
+	// The "from" symbol of the pair.
+	From string `validate:"required" path:"from"`
+
+	// The "to" symbol of the pair.
+	To string `validate:"required" path:"to"`
+
+	// The date of the requested open/close in the format YYYY-MM-DD.
+	Date Date `validate:"required" path:"date"`
+
+	// Whether or not the results are adjusted for splits. By default, results are adjusted. Set this to false to get
+	// results that are NOT adjusted for splits.
+	Adjusted *bool `query:"adjusted"`
+}
+
+func (p GetCryptoDailyOpenCloseParams) WithAdjusted(q bool) *GetCryptoDailyOpenCloseParams {
+	p.Adjusted = &q
+	return &p
+}
+
+// GetCryptoDailyOpenCloseResponse is the response for the GetCryptoDailyOpenClose method.
+type GetCryptoDailyOpenCloseResponse struct {
+	BaseResponse
+	Symbol        string       `json:"symbol,omitempty"`
+	IsUTC         bool         `json:"isUTC,omitempty"`
+	Day           string       `json:"day,omitempty"`
+	Open          float64      `json:"open,omitempty"`
+	Close         float64      `json:"close,omitempty"`
+	OpenTrades    []CryptoTick `json:"openTrades,omitempty"`
+	ClosingTrades []CryptoTick `json:"closingTrades,omitempty"`
+}
+
 // GetPreviousCloseAggParams is the set of parameters for the GetPreviousCloseAgg method.
 type GetPreviousCloseAggParams struct {
 	// The ticker symbol of the stock/equity.
@@ -112,6 +112,93 @@ type GetRealTimeCurrencyConversionResponse struct {
 	LastQuote     ForexQuote `json:"last,omitempty"`
 }
 
+// ListHistoricQuotesParams is the set of parameters for the ListHistoricQuotes method.
+type ListHistoricQuotesParams struct {
+	// The ticker symbol to get quotes for.
+	Ticker string `validate:"required" path:"ticker"`
+
+	// The date of the quotes to retrieve.
+	Date Date `validate:"required" path:"date"`
+
+	// The timestamp offset at which to start the results. The iterator sets it to page through the results.
+	Timestamp *Nanos `query:"timestamp"`
+
+	// The maximum timestamp allowed in the results.
+	TimestampLimit *Nanos `query:"timestampLimit"`
+
+	// Reverse the order of the results.
+	Reverse *bool `query:"reverse"`
+
+	// Limit the number of results returned per page, default is 5000 and max is 50000.
+	Limit *int `query:"limit"`
+}
+
+func (p ListHistoricQuotesParams) WithTimestamp(q Nanos) *ListHistoricQuotesParams {
+	p.Timestamp = &q
+	return &p
+}
+
+func (p ListHistoricQuotesParams) WithTimestampLimit(q Nanos) *ListHistoricQuotesParams {
+	p.TimestampLimit = &q
+	return &p
+}
+
+func (p ListHistoricQuotesParams) WithReverse(q bool) *ListHistoricQuotesParams {
+	p.Reverse = &q
+	return &p
+}
+
+func (p ListHistoricQuotesParams) WithLimit(q int) *ListHistoricQuotesParams {
+	p.Limit = &q
+	return &p
+}
+
+// ListHistoricQuotesResponse is the response returned by the ListHistoricQuotes method.
+type ListHistoricQuotesResponse struct {
+	BaseResponse
+	Ticker       string      `json:"ticker,omitempty"`
+	Success      bool        `json:"success,omitempty"`
+	ResultsCount int         `json:"results_count,omitempty"`
+	Results      []LastQuote `json:"results,omitempty"`
+}
+
+// ListHistoricForexTicksParams is the set of parameters for the ListHistoricForexTicks method.
+type ListHistoricForexTicksParams struct {
+	// The "from" symbol of the pair.
+	From string `validate:"required" path:"from"`
+
+	// The "to" symbol of the pair.
+	To string `validate:"required" path:"to"`
+
+	// The date of the ticks to retrieve.
+	Date Date `validate:"required" path:"date"`
+
+	// The timestamp offset at which to start the results. The iterator sets it to page through the results.
+	Offset *Millis `query:"offset"`
+
+	// Limit the number of results returned per page, max is 10000. The iterator requests the max if it isn't set.
+	Limit *int `query:"limit"`
+}
+
+func (p ListHistoricForexTicksParams) WithOffset(q Millis) *ListHistoricForexTicksParams {
+	p.Offset = &q
+	return &p
+}
+
+func (p ListHistoricForexTicksParams) WithLimit(q int) *ListHistoricForexTicksParams {
+	p.Limit = &q
+	return &p
+}
+
+// ListHistoricForexTicksResponse is the response returned by the ListHistoricForexTicks method.
+type ListHistoricForexTicksResponse struct {
+	BaseResponse
+	Day   string      `json:"day,omitempty"`
+	Pair  string      `json:"pair,omitempty"`
+	Type  string      `json:"type,omitempty"`
+	Ticks []ForexTick `json:"ticks,omitempty"`
+}
+
 // Quote is an NBBO for a ticker symbol in a given time range.
 type Quote struct {
 	AskExchange          int     `json:"ask_exchange,omitempty"`
@@ -154,3 +241,11 @@ type ForexQuote struct {
 	Exchange  int     `json:"exchange,omitempty"`
 	Timestamp Nanos   `json:"timestamp,omitempty"`
 }
+
+// ForexTick is a historic BBO for a forex currency pair.
+type ForexTick struct {
+	Ask       float64 `json:"a,omitempty"`
+	Bid       float64 `json:"b,omitempty"`
+	Exchange  int     `json:"x,omitempty"`
+	Timestamp Millis  `json:"t,omitempty"`
+}
@@ -18,6 +18,15 @@ type RequestOptions struct {
 
 	// Trace enables request tracing
 	Trace bool
+
+	// BypassCache skips the response cache when reading (the response is still stored)
+	BypassCache bool
+
+	// Prefetch is the number of pages that list iterators fetch ahead in the background
+	Prefetch int
+
+	// Resume is a cursor that list iterators continue from instead of starting from the first page
+	Resume *Cursor
 }
 
 // RequestOption changes the configuration of RequestOptions.
@@ -85,3 +94,37 @@ func WithTrace(trace bool) RequestOption {
 		o.Trace = trace
 	}
 }
+
+// BypassCache makes the request skip the client's response cache and fetch a fresh response from the server.
+func BypassCache() RequestOption {
+	return func(o *RequestOptions) {
+		o.BypassCache = true
+	}
+}
+
+// Prefetch makes list iterators fetch up to the given number of pages in the background while the current page
+// is being consumed.
+func Prefetch(pages int) RequestOption {
+	return func(o *RequestOptions) {
+		o.Prefetch = pages
+	}
+}
+
+// Cursor is a position in the results of a list method that iteration can be resumed from. It can be serialized
+// (e.g. as JSON) to checkpoint long-running crawls.
+type Cursor struct {
+	// URL is the request URI of the page that contains the next result. It's empty once all results were read.
+	URL string `json:"url"`
+
+	// Offset is the number of results at the start of the page that were already read.
+	Offset int `json:"offset"`
+}
+
+// ResumeFrom makes a list method continue from a cursor returned by the Cursor method of an earlier iterator
+// instead of starting from the first page. The params passed to the list method are ignored since the cursor
+// already encodes them.
+func ResumeFrom(cursor Cursor) RequestOption {
+	return func(o *RequestOptions) {
+		o.Resume = &cursor
+	}
+}
@@ -1,6 +1,11 @@
 package models
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
 
 // BaseResponse has all possible attributes that any response can use. It's intended to be embedded in a domain specific
 // response struct.
@@ -23,6 +28,12 @@ type BaseResponse struct {
 	ErrorMessage string `json:"error,omitempty"`
 }
 
+// Base returns the base response. It gives code that only knows the interface of a domain specific response (e.g.
+// list iterators) access to the common attributes.
+func (r BaseResponse) Base() BaseResponse {
+	return r
+}
+
 // PaginationHooks are links to next and/or previous pages. Embed this struct into an API response if the endpoint
 // supports pagination.
 type PaginationHooks struct {
@@ -34,15 +45,100 @@ func (p PaginationHooks) NextPage() string {
 	return p.NextURL
 }
 
+// Errors that API calls can be matched against with errors.Is.
+var (
+	// ErrUnauthorized means the API key is missing or invalid.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden means the API key isn't entitled to the requested data.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrNotFound means the requested resource (e.g. a ticker) doesn't exist.
+	ErrNotFound = errors.New("not found")
+
+	// ErrRateLimited means the request was throttled by the API.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrServer means the API failed to process the request.
+	ErrServer = errors.New("server error")
+
+	// ErrDecode means the response body couldn't be decoded.
+	ErrDecode = errors.New("decode error")
+)
+
 // ErrorResponse represents an API response with an error status code.
 type ErrorResponse struct {
 	BaseResponse
 
 	// An HTTP status code for unsuccessful requests.
 	StatusCode int
+
+	// The request URL with any API key redacted.
+	URL string `json:"-"`
+
+	// How long the server asked the client to wait before retrying, if it did.
+	RetryAfter time.Duration `json:"-"`
+
+	// The number of attempts made before giving up, including retries.
+	Attempts int `json:"-"`
 }
 
 // Error returns the details of an error response.
 func (e *ErrorResponse) Error() string {
 	return fmt.Sprintf("bad status with code '%d': message '%s': request ID '%s': internal status: '%s'", e.StatusCode, e.ErrorMessage, e.RequestID, e.Status)
 }
+
+// Is reports whether the error response matches one of the sentinel errors in this package.
+func (e *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// Retryable reports whether the same request might succeed if it's tried again later.
+func (e *ErrorResponse) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// DecodeError is returned when a successful response can't be decoded into the expected model.
+type DecodeError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// A request id assigned by the server.
+	RequestID string
+
+	// The request URL with any API key redacted.
+	URL string
+
+	// The number of attempts made, including retries.
+	Attempts int
+
+	// The underlying decoding error.
+	Err error
+}
+
+// Error returns the details of a decode error.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode response with code '%d': request ID '%s': %v", e.StatusCode, e.RequestID, e.Err)
+}
+
+// Unwrap returns the underlying decoding error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the target is ErrDecode.
+func (e *DecodeError) Is(target error) bool {
+	return target == ErrDecode
+}
@@ -0,0 +1,10 @@
+package models
+
+// GetSECFilingFileParams is the set of parameters for the GetSECFilingFile method.
+type GetSECFilingFileParams struct {
+	// Select by filing id.
+	FilingID string `validate:"required" path:"filing_id"`
+
+	// Select by file id.
+	FileID string `validate:"required" path:"file_id"`
+}
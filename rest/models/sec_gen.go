@@ -0,0 +1,309 @@
+// Code generated by restgen from .massive/rest.json; DO NOT EDIT.
+
+package models
+
+// ListSECFilingsParams is the set of parameters for the ListSECFilings method.
+type ListSECFilingsParams struct {
+	// Query by filing type.
+	Type *string `query:"type"`
+
+	// Query by filing date.
+	FilingDateEQ  *string `query:"filing_date"`
+	FilingDateLT  *string `query:"filing_date.lt"`
+	FilingDateLTE *string `query:"filing_date.lte"`
+	FilingDateGT  *string `query:"filing_date.gt"`
+	FilingDateGTE *string `query:"filing_date.gte"`
+
+	// Query by period of report.
+	PeriodOfReportDateEQ  *string `query:"period_of_report_date"`
+	PeriodOfReportDateLT  *string `query:"period_of_report_date.lt"`
+	PeriodOfReportDateLTE *string `query:"period_of_report_date.lte"`
+	PeriodOfReportDateGT  *string `query:"period_of_report_date.gt"`
+	PeriodOfReportDateGTE *string `query:"period_of_report_date.gte"`
+
+	// If true, query only for filings with an XBRL instance file. If false, query for filings without an XBRL instance
+	// file. If this parameter is not provided, query for filings with or without XBRL instance files.
+	HasXBRL *bool `query:"has_xbrl"`
+
+	// Query by entity company name.
+	EntitiesCompanyDataName *string `query:"entities.company_data.name"`
+
+	// Query by entity company CIK.
+	EntitiesCompanyDataCIK *string `query:"entities.company_data.cik"`
+
+	// Query by entity company ticker.
+	EntitiesCompanyDataTicker *string `query:"entities.company_data.ticker"`
+
+	// Query by entity company SIC.
+	EntitiesCompanyDataSIC *string `query:"entities.company_data.sic"`
+
+	// Search by entities.company_data.name.
+	EntitiesCompanyDataNameSearch *string `query:"entities.company_data.name.search"`
+
+	// Order results based on the `sort` field.
+	Order *Order `query:"order"`
+
+	// Limit the number of results returned, default is 10 and max is 1000.
+	Limit *int `query:"limit"`
+
+	// Sort field used for ordering.
+	Sort *Sort `query:"sort"`
+}
+
+func (p ListSECFilingsParams) WithType(q string) *ListSECFilingsParams {
+	p.Type = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithFilingDate(c Comparator, q string) *ListSECFilingsParams {
+	switch c {
+	case EQ:
+		p.FilingDateEQ = &q
+	case LT:
+		p.FilingDateLT = &q
+	case LTE:
+		p.FilingDateLTE = &q
+	case GT:
+		p.FilingDateGT = &q
+	case GTE:
+		p.FilingDateGTE = &q
+	}
+	return &p
+}
+
+func (p ListSECFilingsParams) WithPeriodOfReportDate(c Comparator, q string) *ListSECFilingsParams {
+	switch c {
+	case EQ:
+		p.PeriodOfReportDateEQ = &q
+	case LT:
+		p.PeriodOfReportDateLT = &q
+	case LTE:
+		p.PeriodOfReportDateLTE = &q
+	case GT:
+		p.PeriodOfReportDateGT = &q
+	case GTE:
+		p.PeriodOfReportDateGTE = &q
+	}
+	return &p
+}
+
+func (p ListSECFilingsParams) WithHasXBRL(q bool) *ListSECFilingsParams {
+	p.HasXBRL = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithEntitiesCompanyDataName(q string) *ListSECFilingsParams {
+	p.EntitiesCompanyDataName = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithEntitiesCompanyDataCIK(q string) *ListSECFilingsParams {
+	p.EntitiesCompanyDataCIK = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithEntitiesCompanyDataTicker(q string) *ListSECFilingsParams {
+	p.EntitiesCompanyDataTicker = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithEntitiesCompanyDataSIC(q string) *ListSECFilingsParams {
+	p.EntitiesCompanyDataSIC = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithEntitiesCompanyDataNameSearch(q string) *ListSECFilingsParams {
+	p.EntitiesCompanyDataNameSearch = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithOrder(q Order) *ListSECFilingsParams {
+	p.Order = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithLimit(q int) *ListSECFilingsParams {
+	p.Limit = &q
+	return &p
+}
+
+func (p ListSECFilingsParams) WithSort(q Sort) *ListSECFilingsParams {
+	p.Sort = &q
+	return &p
+}
+
+// SECFilingEntitiesCompanyData is a model of the ListSECFilings method's response.
+type SECFilingEntitiesCompanyData struct {
+	// Central Index Key (CIK) Number
+	CIK string `json:"cik,omitempty"`
+
+	Name string `json:"name,omitempty"`
+
+	// Standard Industrial Classification (SIC)
+	SIC string `json:"sic,omitempty"`
+
+	// Ticker
+	Ticker string `json:"ticker,omitempty"`
+}
+
+// SECFilingEntities is a model of the ListSECFilings method's response. A filing entity (e.g. the document filer).
+type SECFilingEntities struct {
+	CompanyData SECFilingEntitiesCompanyData `json:"company_data,omitempty"`
+
+	// Relationship of this entity to the filing.
+	Relation string `json:"relation,omitempty"`
+}
+
+// SECFiling is a model of the ListSECFilings method's response.
+type SECFiling struct {
+	// The datetime when the filing was accepted by EDGAR in EST (format: YYYYMMDDHHMMSS)
+	AcceptanceDatetime string `json:"acceptance_datetime,omitempty"`
+
+	// Filing Accession Number
+	AccessionNumber string `json:"accession_number,omitempty"`
+
+	// Entities related to the filing (e.g. the document filers).
+	Entities []SECFilingEntities `json:"entities,omitempty"`
+
+	// The number of files associated with the filing.
+	FilesCount int64 `json:"files_count,omitempty"`
+
+	// The date when the filing was filed in YYYYMMDD format.
+	FilingDate string `json:"filing_date,omitempty"`
+
+	// Unique identifier for the filing.
+	ID string `json:"id,omitempty"`
+
+	// The period of report for the filing in YYYYMMDD format.
+	PeriodOfReportDate string `json:"period_of_report_date,omitempty"`
+
+	// The source URL is a link back to the upstream source for this filing document.
+	SourceURL string `json:"source_url,omitempty"`
+
+	// Filing Type
+	Type string `json:"type,omitempty"`
+}
+
+// ListSECFilingsResponse is the response returned by the ListSECFilings method.
+type ListSECFilingsResponse struct {
+	BaseResponse
+	Results []SECFiling `json:"results,omitempty"`
+}
+
+// GetSECFilingParams is the set of parameters for the GetSECFiling method.
+type GetSECFilingParams struct {
+	// Select by filing id.
+	FilingID string `validate:"required" path:"filing_id"`
+}
+
+// GetSECFilingResponse is the response returned by the GetSECFiling method.
+type GetSECFilingResponse struct {
+	BaseResponse
+	Results SECFiling `json:"results,omitempty"`
+}
+
+// ListSECFilingFilesParams is the set of parameters for the ListSECFilingFiles method.
+type ListSECFilingFilesParams struct {
+	// Select by filing id.
+	FilingID string `validate:"required" path:"filing_id"`
+
+	// Query by file sequence number.
+	SequenceEQ  *int `query:"sequence"`
+	SequenceLT  *int `query:"sequence.lt"`
+	SequenceLTE *int `query:"sequence.lte"`
+	SequenceGT  *int `query:"sequence.gt"`
+	SequenceGTE *int `query:"sequence.gte"`
+
+	// Query by file name.
+	FilenameEQ  *string `query:"filename"`
+	FilenameLT  *string `query:"filename.lt"`
+	FilenameLTE *string `query:"filename.lte"`
+	FilenameGT  *string `query:"filename.gt"`
+	FilenameGTE *string `query:"filename.gte"`
+
+	// Order results based on the `sort` field.
+	Order *Order `query:"order"`
+
+	// Limit the number of results returned, default is 10 and max is 1000.
+	Limit *int `query:"limit"`
+
+	// Sort field used for ordering.
+	Sort *Sort `query:"sort"`
+}
+
+func (p ListSECFilingFilesParams) WithSequence(c Comparator, q int) *ListSECFilingFilesParams {
+	switch c {
+	case EQ:
+		p.SequenceEQ = &q
+	case LT:
+		p.SequenceLT = &q
+	case LTE:
+		p.SequenceLTE = &q
+	case GT:
+		p.SequenceGT = &q
+	case GTE:
+		p.SequenceGTE = &q
+	}
+	return &p
+}
+
+func (p ListSECFilingFilesParams) WithFilename(c Comparator, q string) *ListSECFilingFilesParams {
+	switch c {
+	case EQ:
+		p.FilenameEQ = &q
+	case LT:
+		p.FilenameLT = &q
+	case LTE:
+		p.FilenameLTE = &q
+	case GT:
+		p.FilenameGT = &q
+	case GTE:
+		p.FilenameGTE = &q
+	}
+	return &p
+}
+
+func (p ListSECFilingFilesParams) WithOrder(q Order) *ListSECFilingFilesParams {
+	p.Order = &q
+	return &p
+}
+
+func (p ListSECFilingFilesParams) WithLimit(q int) *ListSECFilingFilesParams {
+	p.Limit = &q
+	return &p
+}
+
+func (p ListSECFilingFilesParams) WithSort(q Sort) *ListSECFilingFilesParams {
+	p.Sort = &q
+	return &p
+}
+
+// SECFilingFile is a model of the ListSECFilingFiles method's response. File associated with the filing.
+type SECFilingFile struct {
+	// A description for the contents of the file.
+	Description string `json:"description,omitempty"`
+
+	// The name for the file.
+	Filename string `json:"filename,omitempty"`
+
+	// An identifier unique to the filing for this data entry.
+	ID string `json:"id,omitempty"`
+
+	// File Sequence Number
+	Sequence int64 `json:"sequence,omitempty"`
+
+	// The size of the file in bytes.
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+
+	// The source URL is a link back to the upstream source for this file.
+	SourceURL string `json:"source_url,omitempty"`
+
+	// The type of document contained in the file.
+	Type string `json:"type,omitempty"`
+}
+
+// ListSECFilingFilesResponse is the response returned by the ListSECFilingFiles method.
+type ListSECFilingFilesResponse struct {
+	BaseResponse
+	Results []SECFilingFile `json:"results,omitempty"`
+}
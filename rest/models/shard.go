@@ -0,0 +1,161 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUnboundedRange is returned when list params can't be split into shards because their timestamp range is
+// missing a lower or upper bound.
+var ErrUnboundedRange = errors.New("a timestamp range with both a lower and an upper bound is required to shard a query")
+
+// splitRange splits the time range [start, end) into up to n consecutive sub-ranges of (roughly) equal length.
+// The returned slice contains the boundaries, so sub-range i is [b[i], b[i+1]).
+func splitRange(start, end time.Time, n int) []time.Time {
+	total := end.Sub(start)
+	if n < 1 {
+		n = 1
+	}
+	if total < time.Duration(n) {
+		n = int(total)
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	bounds := make([]time.Time, n+1)
+	for i := 0; i < n; i++ {
+		bounds[i] = start.Add(time.Duration(int64(total) / int64(n) * int64(i)))
+	}
+	bounds[n] = end
+	return bounds
+}
+
+// timestampRange holds the bounds of a timestamp filter.
+type timestampRange struct {
+	lt, lte, gt, gte *Nanos
+}
+
+// shard splits the range into up to n consecutive ranges. The first and last ranges keep the original lower and
+// upper bound, and the ranges in between are split with gte/lt bounds so that they don't overlap.
+func (r timestampRange) shard(n int) ([]timestampRange, error) {
+	lower, upper := r.gte, r.lt
+	if lower == nil {
+		lower = r.gt
+	}
+	if upper == nil {
+		upper = r.lte
+	}
+	if lower == nil || upper == nil {
+		return nil, ErrUnboundedRange
+	}
+
+	bounds := splitRange(time.Time(*lower), time.Time(*upper), n)
+	shards := make([]timestampRange, len(bounds)-1)
+	for i := range shards {
+		from, to := Nanos(bounds[i]), Nanos(bounds[i+1])
+		shards[i] = timestampRange{gte: &from, lt: &to}
+		if i == 0 {
+			shards[i].gte, shards[i].gt = r.gte, r.gt
+		}
+		if i == len(shards)-1 {
+			shards[i].lt, shards[i].lte = r.lt, r.lte
+		}
+	}
+
+	return shards, nil
+}
+
+// ordered returns the shards as a slice of params in the order that their results are returned in.
+func ordered[T any](shards []*T, order *Order) []any {
+	res := make([]any, len(shards))
+	for i, s := range shards {
+		if order != nil && *order == Desc {
+			res[len(shards)-1-i] = s
+		} else {
+			res[i] = s
+		}
+	}
+	return res
+}
+
+// Shard splits the timestamp range of the params into up to n consecutive sub-ranges and returns params for
+// each of them, ordered the same way as the results (oldest first unless the order is descending). Both a lower
+// (GT or GTE) and an upper (LT or LTE) timestamp bound are required.
+func (p *ListTradesParams) Shard(n int) ([]any, error) {
+	if p == nil {
+		return nil, ErrUnboundedRange
+	}
+
+	ranges, err := timestampRange{lt: p.TimestampLT, lte: p.TimestampLTE, gt: p.TimestampGT, gte: p.TimestampGTE}.shard(n)
+	if err != nil {
+		return nil, err
+	}
+
+	shards := make([]*ListTradesParams, len(ranges))
+	for i, r := range ranges {
+		cp := *p
+		cp.TimestampLT, cp.TimestampLTE, cp.TimestampGT, cp.TimestampGTE = r.lt, r.lte, r.gt, r.gte
+		shards[i] = &cp
+	}
+	return ordered(shards, p.Order), nil
+}
+
+// Shard splits the timestamp range of the params into up to n consecutive sub-ranges and returns params for
+// each of them, ordered the same way as the results (oldest first unless the order is descending). Both a lower
+// (GT or GTE) and an upper (LT or LTE) timestamp bound are required.
+func (p *ListQuotesParams) Shard(n int) ([]any, error) {
+	if p == nil {
+		return nil, ErrUnboundedRange
+	}
+
+	ranges, err := timestampRange{lt: p.TimestampLT, lte: p.TimestampLTE, gt: p.TimestampGT, gte: p.TimestampGTE}.shard(n)
+	if err != nil {
+		return nil, err
+	}
+
+	shards := make([]*ListQuotesParams, len(ranges))
+	for i, r := range ranges {
+		cp := *p
+		cp.TimestampLT, cp.TimestampLTE, cp.TimestampGT, cp.TimestampGTE = r.lt, r.lte, r.gt, r.gte
+		shards[i] = &cp
+	}
+	return ordered(shards, p.Order), nil
+}
+
+// Shard splits the From/To window of the params into up to n consecutive windows and returns params for each
+// of them, ordered the same way as the results (oldest first unless the order is descending). Each aggregate is
+// returned by exactly one shard since the windows don't overlap.
+func (p *ListAggsParams) Shard(n int) ([]any, error) {
+	if p == nil {
+		return nil, ErrUnboundedRange
+	}
+
+	from, to := time.Time(p.From), time.Time(p.To)
+	if from.IsZero() || to.IsZero() {
+		return nil, ErrUnboundedRange
+	}
+
+	// From and To are both inclusive and have millisecond precision, so the windows are split on milliseconds
+	start, end := from.UnixMilli(), to.UnixMilli()+1
+	total := end - start
+	if total < 1 {
+		total = 1
+	}
+	k := int64(max(1, min(n, int(total))))
+
+	shards := make([]*ListAggsParams, k)
+	for i := int64(0); i < k; i++ {
+		cp := *p
+		cp.From = Millis(time.UnixMilli(start + total*i/k))
+		cp.To = Millis(time.UnixMilli(start + total*(i+1)/k - 1))
+		if i == 0 {
+			cp.From = p.From
+		}
+		if i == k-1 {
+			cp.To = p.To
+		}
+		shards[i] = &cp
+	}
+	return ordered(shards, p.Order), nil
+}
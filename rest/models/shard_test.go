@@ -0,0 +1,69 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTradesParamsShard(t *testing.T) {
+	start := time.Date(2021, 7, 22, 0, 0, 0, 0, time.UTC)
+	params := models.ListTradesParams{Ticker: "AAPL"}.
+		WithTimestamp(models.GT, models.Nanos(start)).
+		WithTimestamp(models.LTE, models.Nanos(start.Add(3*time.Hour))).
+		WithOrder(models.Desc)
+
+	shards, err := params.Shard(3)
+	assert.Nil(t, err)
+	assert.Len(t, shards, 3)
+
+	// descending results come from the last shard first
+	last, middle, first := shards[0].(*models.ListTradesParams), shards[1].(*models.ListTradesParams), shards[2].(*models.ListTradesParams)
+	assert.Equal(t, models.Nanos(start), *first.TimestampGT)
+	assert.Nil(t, first.TimestampGTE)
+	assert.Equal(t, models.Nanos(start.Add(time.Hour)), *first.TimestampLT)
+	assert.Equal(t, models.Nanos(start.Add(time.Hour)), *middle.TimestampGTE)
+	assert.Equal(t, models.Nanos(start.Add(2*time.Hour)), *middle.TimestampLT)
+	assert.Equal(t, models.Nanos(start.Add(2*time.Hour)), *last.TimestampGTE)
+	assert.Equal(t, models.Nanos(start.Add(3*time.Hour)), *last.TimestampLTE)
+	assert.Nil(t, last.TimestampLT)
+
+	// the original params are unchanged
+	assert.Nil(t, params.TimestampGTE)
+	assert.Equal(t, "AAPL", middle.Ticker)
+}
+
+func TestListQuotesParamsShardUnbounded(t *testing.T) {
+	_, err := models.ListQuotesParams{Ticker: "AAPL"}.WithDay(2021, 7, 22).Shard(3)
+	assert.ErrorIs(t, err, models.ErrUnboundedRange)
+
+	// ranges that are too short for the number of shards are split into fewer shards
+	start := time.Date(2021, 7, 22, 0, 0, 0, 0, time.UTC)
+	shards, err := models.ListQuotesParams{Ticker: "AAPL"}.
+		WithTimestamp(models.GTE, models.Nanos(start)).
+		WithTimestamp(models.LT, models.Nanos(start.Add(2))).Shard(3)
+	assert.Nil(t, err)
+	assert.Len(t, shards, 2)
+}
+
+func TestListAggsParamsShard(t *testing.T) {
+	start := time.Date(2021, 7, 22, 0, 0, 0, 0, time.UTC)
+	params := &models.ListAggsParams{
+		Ticker:     "AAPL",
+		Multiplier: 1,
+		Timespan:   models.Minute,
+		From:       models.Millis(start),
+		To:         models.Millis(start.Add(4*time.Hour - time.Millisecond)),
+	}
+
+	shards, err := params.Shard(4)
+	assert.Nil(t, err)
+	assert.Len(t, shards, 4)
+	for i, s := range shards {
+		shard := s.(*models.ListAggsParams)
+		assert.True(t, start.Add(time.Duration(i)*time.Hour).Equal(time.Time(shard.From)))
+		assert.True(t, start.Add(time.Duration(i+1)*time.Hour-time.Millisecond).Equal(time.Time(shard.To)))
+	}
+}
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"slices"
+)
+
+// TaxonomyIndex is an in-memory index of ticker taxonomy classifications (see ListTickerTaxonomies) that maps
+// tickers to their classifications and taxonomy tags back to their tickers. It's useful to group results of other
+// methods, e.g. snapshots of all tickers by revenue stream. The zero value isn't usable, use NewTaxonomyIndex.
+//
+// A TaxonomyIndex isn't safe for concurrent use if it's modified with Add.
+type TaxonomyIndex struct {
+	byTicker map[string][]TickerTaxonomy
+	byTag    map[taxonomyTag][]string
+}
+
+// taxonomyTag is a tag within a taxonomy category.
+type taxonomyTag struct {
+	category, tag string
+}
+
+// NewTaxonomyIndex returns an index of the given classifications.
+func NewTaxonomyIndex(classifications ...TickerTaxonomy) *TaxonomyIndex {
+	idx := &TaxonomyIndex{
+		byTicker: make(map[string][]TickerTaxonomy),
+		byTag:    make(map[taxonomyTag][]string),
+	}
+	for _, c := range classifications {
+		idx.Add(c)
+	}
+	return idx
+}
+
+// Add adds a classification to the index. Classifications with the same ticker, category and tag as one that's
+// already indexed are ignored.
+func (idx *TaxonomyIndex) Add(c TickerTaxonomy) {
+	key := taxonomyTag{category: c.Category, tag: c.Tag}
+	tickers := idx.byTag[key]
+	i, found := slices.BinarySearch(tickers, c.Ticker)
+	if found {
+		return
+	}
+	idx.byTag[key] = slices.Insert(tickers, i, c.Ticker)
+	idx.byTicker[c.Ticker] = append(idx.byTicker[c.Ticker], c)
+}
+
+// Len returns the number of classifications in the index.
+func (idx *TaxonomyIndex) Len() int {
+	n := 0
+	for _, classifications := range idx.byTicker {
+		n += len(classifications)
+	}
+	return n
+}
+
+// Classifications returns the classifications of a ticker in the order they were added.
+func (idx *TaxonomyIndex) Classifications(ticker string) []TickerTaxonomy {
+	return slices.Clone(idx.byTicker[ticker])
+}
+
+// Tags returns the tags of a ticker within a category (e.g. "revenue_streams"), in the order they were added.
+func (idx *TaxonomyIndex) Tags(ticker, category string) []string {
+	var tags []string
+	for _, c := range idx.byTicker[ticker] {
+		if c.Category == category {
+			tags = append(tags, c.Tag)
+		}
+	}
+	return tags
+}
+
+// Tickers returns the sorted tickers that are classified with a tag of a category.
+func (idx *TaxonomyIndex) Tickers(category, tag string) []string {
+	return slices.Clone(idx.byTag[taxonomyTag{category: category, tag: tag}])
+}
+
+// CategoryTags returns the sorted tags of a category that at least one ticker is classified with.
+func (idx *TaxonomyIndex) CategoryTags(category string) []string {
+	var tags []string
+	for key := range idx.byTag {
+		if key.category == category {
+			tags = append(tags, key.tag)
+		}
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// GroupSnapshots groups ticker snapshots (e.g. the results of GetAllTickersSnapshot) by their tags within a
+// category. A snapshot whose ticker has several tags is in each of their groups, and snapshots of tickers that
+// aren't classified within the category are grouped under the empty tag. Snapshots keep their order within a group.
+func (idx *TaxonomyIndex) GroupSnapshots(category string, snapshots []TickerSnapshot) map[string][]TickerSnapshot {
+	return GroupByTag(idx, category, snapshots, func(s TickerSnapshot) string { return s.Ticker })
+}
+
+// GroupByTag groups items by the tags of their tickers within a category, like TaxonomyIndex.GroupSnapshots does for
+// snapshots, using the ticker function to get the ticker of an item.
+func GroupByTag[T any](idx *TaxonomyIndex, category string, items []T, ticker func(T) string) map[string][]T {
+	groups := make(map[string][]T)
+	for _, item := range items {
+		tags := idx.Tags(ticker(item), category)
+		if len(tags) == 0 {
+			groups[""] = append(groups[""], item)
+			continue
+		}
+		for _, tag := range tags {
+			groups[tag] = append(groups[tag], item)
+		}
+	}
+	return groups
+}
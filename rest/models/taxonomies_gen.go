@@ -0,0 +1,93 @@
+// Code generated by restgen from .massive/rest.json; DO NOT EDIT.
+
+package models
+
+// ListTickerTaxonomiesParams is the set of parameters for the ListTickerTaxonomies method.
+type ListTickerTaxonomiesParams struct {
+	TickerEQ  *string `query:"ticker"`
+	TickerLT  *string `query:"ticker.lt"`
+	TickerLTE *string `query:"ticker.lte"`
+	TickerGT  *string `query:"ticker.gt"`
+	TickerGTE *string `query:"ticker.gte"`
+
+	// Filter by taxonomy category. The current version of this API supports the following category: revenue_streams
+	Category *string `query:"category"`
+
+	// Filter by taxonomy tag. Each category has a set of associated tags.
+	Tag *string `query:"tag"`
+
+	// Order results ascending or descending based on the ticker.
+	Order *Order `query:"order"`
+
+	// Limit the number of results returned. The default is 10 and the max is 250.
+	Limit *int `query:"limit"`
+
+	// Comma separated list of tickers, up to a maximum of 250.
+	TickerAnyOf *string `query:"ticker.any_of"`
+}
+
+func (p ListTickerTaxonomiesParams) WithTicker(c Comparator, q string) *ListTickerTaxonomiesParams {
+	switch c {
+	case EQ:
+		p.TickerEQ = &q
+	case LT:
+		p.TickerLT = &q
+	case LTE:
+		p.TickerLTE = &q
+	case GT:
+		p.TickerGT = &q
+	case GTE:
+		p.TickerGTE = &q
+	}
+	return &p
+}
+
+func (p ListTickerTaxonomiesParams) WithCategory(q string) *ListTickerTaxonomiesParams {
+	p.Category = &q
+	return &p
+}
+
+func (p ListTickerTaxonomiesParams) WithTag(q string) *ListTickerTaxonomiesParams {
+	p.Tag = &q
+	return &p
+}
+
+func (p ListTickerTaxonomiesParams) WithOrder(q Order) *ListTickerTaxonomiesParams {
+	p.Order = &q
+	return &p
+}
+
+func (p ListTickerTaxonomiesParams) WithLimit(q int) *ListTickerTaxonomiesParams {
+	p.Limit = &q
+	return &p
+}
+
+func (p ListTickerTaxonomiesParams) WithTickerAnyOf(q string) *ListTickerTaxonomiesParams {
+	p.TickerAnyOf = &q
+	return &p
+}
+
+// TickerTaxonomy is a model of the ListTickerTaxonomies method's response.
+type TickerTaxonomy struct {
+	// A dimension of a company’s operating model that is agnostic to industry. Category contains a comprehensive list of
+	// tags which reflect defined types within that category. The current version of this API supports the following
+	// category: revenue_streams
+	Category string `json:"category,omitempty"`
+
+	// The reason why the classification was given. The reason is provided by our AI to help you determine whether or not
+	// you agree with its applicability for your uses.
+	Reason string `json:"reason,omitempty"`
+
+	// A specific type within a category. For example “product_sales” is a type of revenue stream. A company may have
+	// multiple tags within a given category. A taxonomy of tags are determined based on 10k filings.
+	Tag string `json:"tag,omitempty"`
+
+	// The identifying ticker symbol for the asset.
+	Ticker string `json:"ticker,omitempty"`
+}
+
+// ListTickerTaxonomiesResponse is the response returned by the ListTickerTaxonomies method.
+type ListTickerTaxonomiesResponse struct {
+	BaseResponse
+	Results []TickerTaxonomy `json:"results,omitempty"`
+}
@@ -0,0 +1,46 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxonomyIndex(t *testing.T) {
+	idx := models.NewTaxonomyIndex(
+		models.TickerTaxonomy{Ticker: "MSFT", Category: "revenue_streams", Tag: "subscription_fees"},
+		models.TickerTaxonomy{Ticker: "AAPL", Category: "revenue_streams", Tag: "product_sales"},
+		models.TickerTaxonomy{Ticker: "AAPL", Category: "revenue_streams", Tag: "subscription_fees"},
+		models.TickerTaxonomy{Ticker: "AAPL", Category: "revenue_streams", Tag: "product_sales", Reason: "duplicate"},
+	)
+	idx.Add(models.TickerTaxonomy{Ticker: "AAPL", Category: "other", Tag: "hardware"})
+
+	assert.Equal(t, 4, idx.Len())
+	assert.Equal(t, []string{"product_sales", "subscription_fees"}, idx.Tags("AAPL", "revenue_streams"))
+	assert.Equal(t, []string{"hardware"}, idx.Tags("AAPL", "other"))
+	assert.Empty(t, idx.Tags("GOOG", "revenue_streams"))
+	assert.Len(t, idx.Classifications("AAPL"), 3)
+	assert.Empty(t, idx.Classifications("AAPL")[0].Reason)
+
+	assert.Equal(t, []string{"AAPL", "MSFT"}, idx.Tickers("revenue_streams", "subscription_fees"))
+	assert.Equal(t, []string{"AAPL"}, idx.Tickers("revenue_streams", "product_sales"))
+	assert.Empty(t, idx.Tickers("other", "product_sales"))
+	assert.Equal(t, []string{"product_sales", "subscription_fees"}, idx.CategoryTags("revenue_streams"))
+}
+
+func TestTaxonomyIndexGroupSnapshots(t *testing.T) {
+	idx := models.NewTaxonomyIndex(
+		models.TickerTaxonomy{Ticker: "AAPL", Category: "revenue_streams", Tag: "product_sales"},
+		models.TickerTaxonomy{Ticker: "AAPL", Category: "revenue_streams", Tag: "subscription_fees"},
+		models.TickerTaxonomy{Ticker: "MSFT", Category: "revenue_streams", Tag: "subscription_fees"},
+	)
+	aapl, msft, goog := models.TickerSnapshot{Ticker: "AAPL"}, models.TickerSnapshot{Ticker: "MSFT"}, models.TickerSnapshot{Ticker: "GOOG"}
+
+	groups := idx.GroupSnapshots("revenue_streams", []models.TickerSnapshot{msft, aapl, goog})
+	assert.Equal(t, map[string][]models.TickerSnapshot{
+		"product_sales":     {aapl},
+		"subscription_fees": {msft, aapl},
+		"":                  {goog},
+	}, groups)
+}
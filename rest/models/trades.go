@@ -96,6 +96,93 @@ type GetLastCryptoTradeResponse struct {
 	Last   CryptoTrade `json:"last,omitempty"`
 }
 
+// ListHistoricTradesParams is the set of parameters for the ListHistoricTrades method.
+type ListHistoricTradesParams struct {
+	// The ticker symbol to get trades for.
+	Ticker string `validate:"required" path:"ticker"`
+
+	// The date of the trades to retrieve.
+	Date Date `validate:"required" path:"date"`
+
+	// The timestamp offset at which to start the results. The iterator sets it to page through the results.
+	Timestamp *Nanos `query:"timestamp"`
+
+	// The maximum timestamp allowed in the results.
+	TimestampLimit *Nanos `query:"timestampLimit"`
+
+	// Reverse the order of the results.
+	Reverse *bool `query:"reverse"`
+
+	// Limit the number of results returned per page, default is 5000 and max is 50000.
+	Limit *int `query:"limit"`
+}
+
+func (p ListHistoricTradesParams) WithTimestamp(q Nanos) *ListHistoricTradesParams {
+	p.Timestamp = &q
+	return &p
+}
+
+func (p ListHistoricTradesParams) WithTimestampLimit(q Nanos) *ListHistoricTradesParams {
+	p.TimestampLimit = &q
+	return &p
+}
+
+func (p ListHistoricTradesParams) WithReverse(q bool) *ListHistoricTradesParams {
+	p.Reverse = &q
+	return &p
+}
+
+func (p ListHistoricTradesParams) WithLimit(q int) *ListHistoricTradesParams {
+	p.Limit = &q
+	return &p
+}
+
+// ListHistoricTradesResponse is the response returned by the ListHistoricTrades method.
+type ListHistoricTradesResponse struct {
+	BaseResponse
+	Ticker       string      `json:"ticker,omitempty"`
+	Success      bool        `json:"success,omitempty"`
+	ResultsCount int         `json:"results_count,omitempty"`
+	Results      []LastTrade `json:"results,omitempty"`
+}
+
+// ListHistoricCryptoTradesParams is the set of parameters for the ListHistoricCryptoTrades method.
+type ListHistoricCryptoTradesParams struct {
+	// The "from" symbol of the pair.
+	From string `validate:"required" path:"from"`
+
+	// The "to" symbol of the pair.
+	To string `validate:"required" path:"to"`
+
+	// The date of the trades to retrieve.
+	Date Date `validate:"required" path:"date"`
+
+	// The timestamp offset at which to start the results. The iterator sets it to page through the results.
+	Offset *Millis `query:"offset"`
+
+	// Limit the number of results returned per page, max is 10000. The iterator requests the max if it isn't set.
+	Limit *int `query:"limit"`
+}
+
+func (p ListHistoricCryptoTradesParams) WithOffset(q Millis) *ListHistoricCryptoTradesParams {
+	p.Offset = &q
+	return &p
+}
+
+func (p ListHistoricCryptoTradesParams) WithLimit(q int) *ListHistoricCryptoTradesParams {
+	p.Limit = &q
+	return &p
+}
+
+// ListHistoricCryptoTradesResponse is the response returned by the ListHistoricCryptoTrades method.
+type ListHistoricCryptoTradesResponse struct {
+	BaseResponse
+	Day    string       `json:"day,omitempty"`
+	Symbol string       `json:"symbol,omitempty"`
+	Type   string       `json:"type,omitempty"`
+	Ticks  []CryptoTick `json:"ticks,omitempty"`
+}
+
 // Trade contains trade data for a specified ticker symbol.
 type Trade struct {
 	Conditions           []int32 `json:"conditions,omitempty"`
@@ -137,3 +224,13 @@ type CryptoTrade struct {
 	Size       float64 `json:"size,omitempty"`
 	Timestamp  Nanos   `json:"timestamp,omitempty"`
 }
+
+// CryptoTick is a historic trade for a crypto pair.
+type CryptoTick struct {
+	Conditions []int32 `json:"c,omitempty"`
+	ID         string  `json:"i,omitempty"`
+	Price      float64 `json:"p,omitempty"`
+	Size       float64 `json:"s,omitempty"`
+	Timestamp  Millis  `json:"t,omitempty"`
+	Exchange   int     `json:"x,omitempty"`
+}
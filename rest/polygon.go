@@ -21,23 +21,25 @@ type Client struct {
 	VX VXClient
 }
 
-// New creates a client for the Massive REST API.
-func New(apiKey string) *Client {
-	return newClient(apiKey, nil)
+// New creates a client for the Massive REST API. Client options such as client.WithRateLimit apply to every
+// domain specific client.
+func New(apiKey string, opts ...client.Option) *Client {
+	return NewWithOptions(apiKey, opts...)
 }
 
 // NewWithClient creates a client for the Massive REST API using a custom HTTP client.
-func NewWithClient(apiKey string, hc *http.Client) *Client {
-	return newClient(apiKey, hc)
+func NewWithClient(apiKey string, hc *http.Client, opts ...client.Option) *Client {
+	return NewWithOptions(apiKey, append([]client.Option{client.WithHTTPClient(hc)}, opts...)...)
 }
 
-func newClient(apiKey string, hc *http.Client) *Client {
-	var c client.Client
-	if hc == nil {
-		c = client.New(apiKey)
-	} else {
-		c = client.NewWithClient(apiKey, hc)
-	}
+// NewWithOptions creates a client for the Massive REST API configured by the given options, e.g.
+//
+//	c := massive.NewWithOptions(apiKey,
+//		client.WithBaseURL("http://localhost:8080"),
+//		client.WithTimeout(30*time.Second),
+//		client.WithUserAgentSuffix("myapp/1.0"))
+func NewWithOptions(apiKey string, opts ...client.Option) *Client {
+	c := client.New(apiKey, opts...)
 
 	return &Client{
 		Client:           c,
@@ -3,6 +3,7 @@ package massive
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/massive-com/client-go/v2/rest/client"
 	"github.com/massive-com/client-go/v2/rest/iter"
@@ -14,6 +15,9 @@ const (
 	GetLastQuotePath                  = "/v2/last/nbbo/{ticker}"
 	GetLastForexQuotePath             = "/v1/last_quote/currencies/{from}/{to}"
 	GetRealTimeCurrencyConversionPath = "/v1/conversion/{from}/{to}"
+
+	ListHistoricQuotesPath     = "/v2/ticks/stocks/nbbo/{ticker}/{date}"
+	ListHistoricForexTicksPath = "/v1/historic/forex/{from}/{to}/{date}"
 )
 
 // QuotesClient defines a REST client for the Massive quotes API.
@@ -34,11 +38,21 @@ type QuotesClient struct {
 //		return iter.Err()
 //	}
 func (c *QuotesClient) ListQuotes(ctx context.Context, params *models.ListQuotesParams, options ...models.RequestOption) *iter.Iter[models.Quote] {
-	return iter.NewIter(ctx, ListQuotesPath, params, func(uri string) (iter.ListResponse, []models.Quote, error) {
+	return iter.NewIter(ctx, ListQuotesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.Quote, error) {
 		res := &models.ListQuotesResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListQuotesPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
+}
+
+// ListQuotesSharded is like ListQuotes but splits the timestamp range of the params into shards that are fetched
+// concurrently (see iter.NewShardedIter).
+func (c *QuotesClient) ListQuotesSharded(ctx context.Context, params *models.ListQuotesParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Quote] {
+	return iter.NewShardedIter(ctx, ListQuotesPath, params, shards, workers, func(ctx context.Context, uri string) (iter.ListResponse, []models.Quote, error) {
+		res := &models.ListQuotesResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListQuotesPath, uri, res, options...)
+		return res, res.Results, err
+	}, options...)
 }
 
 // GetLastQuote retrieves the last quote (NBBO) for a specified ticker. For more details see
@@ -65,3 +79,61 @@ func (c *QuotesClient) GetRealTimeCurrencyConversion(ctx context.Context, params
 	err := c.Call(ctx, http.MethodGet, GetRealTimeCurrencyConversionPath, params, res, options...)
 	return res, err
 }
+
+// ListHistoricQuotes retrieves the NBBO quotes of a ticker on a given day from the legacy v2 ticks API, which pages
+// through results by SIP timestamp offset rather than next URLs. Each page starts at the timestamp where the page
+// before it ends (see iter.OffsetPage). The API deprecated this endpoint in favor of ListQuotes.
+//
+// This method returns an iterator that should be used to access the results via this pattern:
+//
+//	iter := c.ListHistoricQuotes(context.TODO(), params, opts...)
+//	for iter.Next() {
+//		log.Print(iter.Item()) // do something with the current value
+//	}
+//	if iter.Err() != nil {
+//		return iter.Err()
+//	}
+func (c *QuotesClient) ListHistoricQuotes(ctx context.Context, params *models.ListHistoricQuotesParams, options ...models.RequestOption) *iter.Iter[models.LastQuote] {
+	return iter.NewIter(ctx, ListHistoricQuotesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.LastQuote, error) {
+		res := &models.ListHistoricQuotesResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListHistoricQuotesPath, uri, res, options...)
+		if err != nil {
+			return res, res.Results, err
+		}
+		res.NextURL, res.Results, err = iter.OffsetPage(uri, "timestamp", historicTicksDefaultLimit, res.Results, func(q models.LastQuote) int64 {
+			return time.Time(q.SipTimestamp).UnixNano()
+		})
+		return res, res.Results, err
+	}, options...)
+}
+
+// ListHistoricForexTicks retrieves the BBO ticks of a forex currency pair on a given day from the legacy v1
+// historic API, which pages through results by timestamp offset rather than next URLs. Each page starts at the
+// timestamp where the page before it ends (see iter.OffsetPage). The API deprecated this endpoint in favor of
+// ListQuotes.
+//
+// This method returns an iterator that should be used to access the results via this pattern:
+//
+//	iter := c.ListHistoricForexTicks(context.TODO(), params, opts...)
+//	for iter.Next() {
+//		log.Print(iter.Item()) // do something with the current value
+//	}
+//	if iter.Err() != nil {
+//		return iter.Err()
+//	}
+func (c *QuotesClient) ListHistoricForexTicks(ctx context.Context, params *models.ListHistoricForexTicksParams, options ...models.RequestOption) *iter.Iter[models.ForexTick] {
+	if params != nil && params.Limit == nil {
+		params = params.WithLimit(historicTicksMaxLimit)
+	}
+	return iter.NewIter(ctx, ListHistoricForexTicksPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.ForexTick, error) {
+		res := &models.ListHistoricForexTicksResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListHistoricForexTicksPath, uri, res, options...)
+		if err != nil {
+			return res, res.Ticks, err
+		}
+		res.NextURL, res.Ticks, err = iter.OffsetPage(uri, "offset", historicTicksMaxLimit, res.Ticks, func(t models.ForexTick) int64 {
+			return time.Time(t.Timestamp).UnixMilli()
+		})
+		return res, res.Ticks, err
+	}, options...)
+}
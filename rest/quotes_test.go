@@ -190,3 +190,132 @@ func TestGetRealTimeCurrencyConversion(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, &expect, res)
 }
+
+func TestListHistoricQuotes(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder("https://api.massive.com/v2/ticks/stocks/nbbo/AAPL/2020-10-14?reverse=true", `{
+	"ticker": "AAPL",
+	"success": true,
+	"results_count": 2,
+	"results": [
+		{"P": 0, "S": 0, "X": 0, "c": [1], "p": 170, "q": 2061, "s": 2, "t": 1517562000065791500, "x": 11, "y": 1517562000065408300, "z": 3},
+		{"P": 0, "S": 0, "X": 0, "c": [1], "p": 102.7, "q": 2060, "s": 60, "t": 1517562000065700400, "x": 11, "y": 1517562000065321200, "z": 3}
+	]
+}`)
+
+	params := models.ListHistoricQuotesParams{
+		Ticker: "AAPL",
+		Date:   models.Date(time.Date(2020, 10, 14, 0, 0, 0, 0, time.UTC)),
+	}.WithReverse(true)
+	var sequenceNumbers []int64
+	iter := c.ListHistoricQuotes(context.Background(), params)
+	for iter.Next() {
+		sequenceNumbers = append(sequenceNumbers, iter.Item().SequenceNumber)
+	}
+	assert.Nil(t, iter.Err())
+	assert.Equal(t, []int64{2061, 2060}, sequenceNumbers)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestListHistoricQuotesReverse(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder("https://api.massive.com/v2/ticks/stocks/nbbo/AAPL/2020-10-14?limit=2&reverse=true", `{
+	"ticker": "AAPL",
+	"success": true,
+	"results_count": 2,
+	"results": [
+		{"P": 0, "S": 0, "X": 0, "c": [1], "p": 170, "q": 2062, "s": 2, "t": 1517562000065791500, "x": 11, "y": 1517562000065408300, "z": 3},
+		{"P": 0, "S": 0, "X": 0, "c": [1], "p": 170, "q": 2061, "s": 2, "t": 1517562000065700400, "x": 11, "y": 1517562000065408300, "z": 3}
+	]
+}`)
+	// reversed pages continue backwards from the timestamp where the page before them ended
+	registerResponder("https://api.massive.com/v2/ticks/stocks/nbbo/AAPL/2020-10-14?limit=2&reverse=true&timestamp=1517562000065700400", `{
+	"ticker": "AAPL",
+	"success": true,
+	"results_count": 2,
+	"results": [
+		{"P": 0, "S": 0, "X": 0, "c": [1], "p": 170, "q": 2061, "s": 2, "t": 1517562000065700400, "x": 11, "y": 1517562000065408300, "z": 3},
+		{"P": 0, "S": 0, "X": 0, "c": [1], "p": 102.7, "q": 2060, "s": 60, "t": 1517562000065600000, "x": 11, "y": 1517562000065321200, "z": 3}
+	]
+}`)
+	registerResponder("https://api.massive.com/v2/ticks/stocks/nbbo/AAPL/2020-10-14?limit=2&reverse=true&timestamp=1517562000065600000", `{
+	"ticker": "AAPL",
+	"success": true,
+	"results_count": 1,
+	"results": [
+		{"P": 0, "S": 0, "X": 0, "c": [1], "p": 102.7, "q": 2060, "s": 60, "t": 1517562000065600000, "x": 11, "y": 1517562000065321200, "z": 3}
+	]
+}`)
+
+	params := models.ListHistoricQuotesParams{
+		Ticker: "AAPL",
+		Date:   models.Date(time.Date(2020, 10, 14, 0, 0, 0, 0, time.UTC)),
+	}.WithLimit(2).WithReverse(true)
+	var sequenceNumbers []int64
+	iter := c.ListHistoricQuotes(context.Background(), params)
+	for iter.Next() {
+		sequenceNumbers = append(sequenceNumbers, iter.Item().SequenceNumber)
+	}
+	assert.Nil(t, iter.Err())
+	assert.Equal(t, []int64{2062, 2061, 2060}, sequenceNumbers)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestListHistoricForexTicks(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder("https://api.massive.com/v1/historic/forex/AUD/USD/2020-10-14?limit=2", `{
+	"day": "2020-10-14",
+	"pair": "AUD/USD",
+	"status": "success",
+	"ticks": [
+		{"a": 0.71703, "b": 0.71701, "t": 1602633600000, "x": 48},
+		{"a": 0.71703, "b": 0.717, "t": 1602633600001, "x": 48}
+	],
+	"type": "forex"
+}`)
+	registerResponder("https://api.massive.com/v1/historic/forex/AUD/USD/2020-10-14?limit=2&offset=1602633600001", `{
+	"day": "2020-10-14",
+	"pair": "AUD/USD",
+	"status": "success",
+	"ticks": [
+		{"a": 0.71703, "b": 0.717, "t": 1602633600001, "x": 48},
+		{"a": 0.71702, "b": 0.717, "t": 1602633600002, "x": 48}
+	],
+	"type": "forex"
+}`)
+	registerResponder("https://api.massive.com/v1/historic/forex/AUD/USD/2020-10-14?limit=2&offset=1602633600002", `{
+	"day": "2020-10-14",
+	"pair": "AUD/USD",
+	"status": "success",
+	"ticks": [
+		{"a": 0.71702, "b": 0.717, "t": 1602633600002, "x": 48}
+	],
+	"type": "forex"
+}`)
+
+	params := models.ListHistoricForexTicksParams{
+		From: "AUD",
+		To:   "USD",
+		Date: models.Date(time.Date(2020, 10, 14, 0, 0, 0, 0, time.UTC)),
+	}.WithLimit(2)
+	var asks []float64
+	iter := c.ListHistoricForexTicks(context.Background(), params)
+	for iter.Next() {
+		asks = append(asks, iter.Item().Ask)
+	}
+	assert.Nil(t, iter.Err())
+	assert.Equal(t, []float64{0.71703, 0.71703, 0.71702}, asks)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
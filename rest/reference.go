@@ -28,6 +28,16 @@ const (
 	ListTreasuryYieldsPath        = "/fed/v1/treasury-yields"
 )
 
+// CacheablePaths are the reference endpoints whose responses rarely change, which client.WithCache caches by
+// default (see client.DefaultCachePaths).
+var CacheablePaths = []string{
+	GetTickerDetailsPath,
+	GetTickerTypesPath,
+	GetMarketHolidaysPath,
+	ListConditionsPath,
+	GetExchangesPath,
+}
+
 // ReferenceClient defines a REST client for the Massive reference API.
 type ReferenceClient struct {
 	client.Client
@@ -46,11 +56,11 @@ type ReferenceClient struct {
 //		return iter.Err()
 //	}
 func (c *ReferenceClient) ListTickers(ctx context.Context, params *models.ListTickersParams, options ...models.RequestOption) *iter.Iter[models.Ticker] {
-	return iter.NewIter(ctx, ListTickersPath, params, func(uri string) (iter.ListResponse, []models.Ticker, error) {
+	return iter.NewIter(ctx, ListTickersPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.Ticker, error) {
 		res := &models.ListTickersResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListTickersPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetTickerDetails retrieves details for a specified ticker. For more details see
@@ -74,11 +84,11 @@ func (c *ReferenceClient) GetTickerDetails(ctx context.Context, params *models.G
 //		return iter.Err()
 //	}
 func (c *ReferenceClient) ListTickerNews(ctx context.Context, params *models.ListTickerNewsParams, options ...models.RequestOption) *iter.Iter[models.TickerNews] {
-	return iter.NewIter(ctx, ListTickerNewsPath, params, func(uri string) (iter.ListResponse, []models.TickerNews, error) {
+	return iter.NewIter(ctx, ListTickerNewsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.TickerNews, error) {
 		res := &models.ListTickerNewsResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListTickerNewsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetTickerRelatedCompanies gets a list of related tickers based on news and returns data. For more details see
@@ -125,11 +135,11 @@ func (c *ReferenceClient) GetMarketStatus(ctx context.Context, options ...models
 //		return iter.Err()
 //	}
 func (c *ReferenceClient) ListSplits(ctx context.Context, params *models.ListSplitsParams, options ...models.RequestOption) *iter.Iter[models.Split] {
-	return iter.NewIter(ctx, ListSplitsPath, params, func(uri string) (iter.ListResponse, []models.Split, error) {
+	return iter.NewIter(ctx, ListSplitsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.Split, error) {
 		res := &models.ListSplitsResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListSplitsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListDividends retrieves reference dividends. For more details see
@@ -145,11 +155,11 @@ func (c *ReferenceClient) ListSplits(ctx context.Context, params *models.ListSpl
 //		return iter.Err()
 //	}
 func (c *ReferenceClient) ListDividends(ctx context.Context, params *models.ListDividendsParams, options ...models.RequestOption) *iter.Iter[models.Dividend] {
-	return iter.NewIter(ctx, ListDividendsPath, params, func(uri string) (iter.ListResponse, []models.Dividend, error) {
+	return iter.NewIter(ctx, ListDividendsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.Dividend, error) {
 		res := &models.ListDividendsResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListDividendsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListConditions retrieves reference conditions. For more details see
@@ -165,11 +175,11 @@ func (c *ReferenceClient) ListDividends(ctx context.Context, params *models.List
 //		return iter.Err()
 //	}
 func (c *ReferenceClient) ListConditions(ctx context.Context, params *models.ListConditionsParams, options ...models.RequestOption) *iter.Iter[models.Condition] {
-	return iter.NewIter(ctx, ListConditionsPath, params, func(uri string) (iter.ListResponse, []models.Condition, error) {
+	return iter.NewIter(ctx, ListConditionsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.Condition, error) {
 		res := &models.ListConditionsResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListConditionsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetExchanges lists all exchanges that Massive knows about. For more details see
@@ -201,11 +211,11 @@ func (c *ReferenceClient) GetOptionsContract(ctx context.Context, params *models
 //		return iter.Err()
 //	}
 func (c *ReferenceClient) ListOptionsContracts(ctx context.Context, params *models.ListOptionsContractsParams, options ...models.RequestOption) *iter.Iter[models.OptionsContract] {
-	return iter.NewIter(ctx, ListOptionsContractsPath, params, func(uri string) (iter.ListResponse, []models.OptionsContract, error) {
+	return iter.NewIter(ctx, ListOptionsContractsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.OptionsContract, error) {
 		res := &models.ListOptionsContractsResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListOptionsContractsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListShortInterest retrieves bi-monthly aggregated short interest data
@@ -220,11 +230,11 @@ func (c *ReferenceClient) ListOptionsContracts(ctx context.Context, params *mode
 // Use Cases: Market sentiment analysis, short-squeeze prediction, risk
 // management, trading strategy refinement.
 func (c *ReferenceClient) ListShortInterest(ctx context.Context, params *models.ListShortInterestParams, options ...models.RequestOption) *iter.Iter[models.ShortInterest] {
-	return iter.NewIter(ctx, ListShortInterestPath, params, func(uri string) (iter.ListResponse, []models.ShortInterest, error) {
+	return iter.NewIter(ctx, ListShortInterestPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.ShortInterest, error) {
 		res := &models.ListShortInterestResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListShortInterestPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListShortVolume retrieves daily aggregated short sale volume data reported
@@ -239,11 +249,11 @@ func (c *ReferenceClient) ListShortInterest(ctx context.Context, params *models.
 // Use Cases: Intraday sentiment analysis, short-sale trend identification,
 // liquidity analysis, trading strategy optimization.
 func (c *ReferenceClient) ListShortVolume(ctx context.Context, params *models.ListShortVolumeParams, options ...models.RequestOption) *iter.Iter[models.ShortVolume] {
-	return iter.NewIter(ctx, ListShortVolumePath, params, func(uri string) (iter.ListResponse, []models.ShortVolume, error) {
+	return iter.NewIter(ctx, ListShortVolumePath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.ShortVolume, error) {
 		res := &models.ListShortVolumeResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListShortVolumePath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // ListTreasuryYields retrieves historical U.S. Treasury yield data for
@@ -256,9 +266,9 @@ func (c *ReferenceClient) ListShortVolume(ctx context.Context, params *models.Li
 // Use Cases: Charting rate trends, comparing short vs. long-term yields,
 // economic research.
 func (c *ReferenceClient) ListTreasuryYields(ctx context.Context, params *models.ListTreasuryYieldsParams, options ...models.RequestOption) *iter.Iter[models.TreasuryYield] {
-	return iter.NewIter(ctx, ListTreasuryYieldsPath, params, func(uri string) (iter.ListResponse, []models.TreasuryYield, error) {
+	return iter.NewIter(ctx, ListTreasuryYieldsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.TreasuryYield, error) {
 		res := &models.ListTreasuryYieldsResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListTreasuryYieldsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
@@ -8,10 +8,15 @@ import (
 
 	"github.com/jarcoal/httpmock"
 	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/client"
 	"github.com/massive-com/client-go/v2/rest/models"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestCacheablePaths(t *testing.T) {
+	assert.ElementsMatch(t, client.DefaultCachePaths, massive.CacheablePaths)
+}
+
 func TestListTickers(t *testing.T) {
 	c := massive.New("API_KEY")
 
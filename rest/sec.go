@@ -0,0 +1,26 @@
+package massive
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+const GetSECFilingFilePath = "/v1/reference/sec/filings/{filing_id}/files/{file_id}"
+
+// GetSECFilingFile retrieves the contents of a file of an SEC filing (see ListSECFilingFiles). The file is streamed
+// rather than read into memory, so the caller must close the returned body:
+//
+//	body, err := c.GetSECFilingFile(context.TODO(), params, opts...)
+//	if err != nil {
+//		return err
+//	}
+//	defer body.Close()
+//	_, err = io.Copy(w, body)
+//
+// Note: this method utilizes an experimental API and could experience breaking changes or deprecation.
+func (c *ReferenceClient) GetSECFilingFile(ctx context.Context, params *models.GetSECFilingFileParams, options ...models.RequestOption) (io.ReadCloser, error) {
+	return c.CallStream(ctx, http.MethodGet, GetSECFilingFilePath, params, options...)
+}
@@ -0,0 +1,68 @@
+// Code generated by restgen from .massive/rest.json; DO NOT EDIT.
+
+package massive
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+const (
+	ListSECFilingsPath     = "/v1/reference/sec/filings"
+	GetSECFilingPath       = "/v1/reference/sec/filings/{filing_id}"
+	ListSECFilingFilesPath = "/v1/reference/sec/filings/{filing_id}/files"
+)
+
+// ListSECFilings retrieves a list of SEC filings (e.g. 10-K and 10-Q reports) that match the params.
+//
+// Note: this method utilizes an experimental API and could experience breaking changes or deprecation.
+//
+// This method returns an iterator that should be used to access the results via this pattern:
+//
+//	iter := c.ListSECFilings(context.TODO(), params, opts...)
+//	for iter.Next() {
+//		log.Print(iter.Item()) // do something with the current value
+//	}
+//	if iter.Err() != nil {
+//		return iter.Err()
+//	}
+func (c *ReferenceClient) ListSECFilings(ctx context.Context, params *models.ListSECFilingsParams, options ...models.RequestOption) *iter.Iter[models.SECFiling] {
+	return iter.NewIter(ctx, ListSECFilingsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.SECFiling, error) {
+		res := &models.ListSECFilingsResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListSECFilingsPath, uri, res, options...)
+		return res, res.Results, err
+	}, options...)
+}
+
+// GetSECFiling retrieves an SEC filing by its ID.
+//
+// Note: this method utilizes an experimental API and could experience breaking changes or deprecation.
+func (c *ReferenceClient) GetSECFiling(ctx context.Context, params *models.GetSECFilingParams, options ...models.RequestOption) (*models.GetSECFilingResponse, error) {
+	res := &models.GetSECFilingResponse{}
+	err := c.Call(ctx, http.MethodGet, GetSECFilingPath, params, res, options...)
+	return res, err
+}
+
+// ListSECFilingFiles retrieves a list of the files of an SEC filing.
+//
+// Note: this method utilizes an experimental API and could experience breaking changes or deprecation.
+//
+// This method returns an iterator that should be used to access the results via this pattern:
+//
+//	iter := c.ListSECFilingFiles(context.TODO(), params, opts...)
+//	for iter.Next() {
+//		log.Print(iter.Item()) // do something with the current value
+//	}
+//	if iter.Err() != nil {
+//		return iter.Err()
+//	}
+func (c *ReferenceClient) ListSECFilingFiles(ctx context.Context, params *models.ListSECFilingFilesParams, options ...models.RequestOption) *iter.Iter[models.SECFilingFile] {
+	return iter.NewIter(ctx, ListSECFilingFilesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.SECFilingFile, error) {
+		res := &models.ListSECFilingFilesResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListSECFilingFilesPath, uri, res, options...)
+		return res, res.Results, err
+	}, options...)
+}
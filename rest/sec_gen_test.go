@@ -0,0 +1,147 @@
+// Code generated by restgen from .massive/rest.json; DO NOT EDIT.
+
+package massive_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSECFilings(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	expectedResponse := `{
+		"count": 1,
+		"request_id": "x",
+		"results": [
+			{
+				"acceptance_datetime": "x",
+				"accession_number": "x",
+				"entities": [
+					{
+						"company_data": {
+							"cik": "x",
+							"name": "Facebook Inc",
+							"sic": "x",
+							"ticker": "x"
+						},
+						"relation": "x"
+					}
+				],
+				"files_count": 1,
+				"filing_date": "20210101",
+				"id": "x",
+				"period_of_report_date": "20210101",
+				"source_url": "https://www.sec.gov/Archives/edgar/data/0001326801/000132680119000037/0001326801-19-000037-index.html",
+				"type": "x"
+			}
+		],
+		"status": "x"
+	}`
+
+	registerResponder("https://api.massive.com/v1/reference/sec/filings", expectedResponse)
+
+	var expect models.ListSECFilingsResponse
+	err := json.Unmarshal([]byte(expectedResponse), &expect)
+	assert.Nil(t, err)
+
+	iter := c.ListSECFilings(context.Background(), &models.ListSECFilingsParams{})
+	var results []models.SECFiling
+	for iter.Next() {
+		results = append(results, iter.Item())
+	}
+	assert.Nil(t, iter.Err())
+	assert.ElementsMatch(t, expect.Results, results)
+}
+
+func TestGetSECFiling(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	expectedResponse := `{
+		"results": {
+			"acceptance_datetime": "x",
+			"accession_number": "x",
+			"entities": [
+				{
+					"company_data": {
+						"cik": "x",
+						"name": "Facebook Inc",
+						"sic": "x",
+						"ticker": "x"
+					},
+					"relation": "x"
+				}
+			],
+			"files_count": 1,
+			"filing_date": "20210101",
+			"id": "x",
+			"period_of_report_date": "20210101",
+			"source_url": "https://www.sec.gov/Archives/edgar/data/0001326801/000132680119000037/0001326801-19-000037-index.html",
+			"type": "x"
+		}
+	}`
+
+	registerResponder("https://api.massive.com/v1/reference/sec/filings/X", expectedResponse)
+
+	var expect models.GetSECFilingResponse
+	err := json.Unmarshal([]byte(expectedResponse), &expect)
+	assert.Nil(t, err)
+
+	res, err := c.GetSECFiling(context.Background(), &models.GetSECFilingParams{
+		FilingID: "X",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &expect, res)
+}
+
+func TestListSECFilingFiles(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	expectedResponse := `{
+		"count": 1,
+		"request_id": "x",
+		"results": [
+			{
+				"description": "x",
+				"filename": "x",
+				"id": "1",
+				"sequence": 1,
+				"size_bytes": 1,
+				"source_url": "x",
+				"type": "x"
+			}
+		],
+		"status": "x"
+	}`
+
+	registerResponder("https://api.massive.com/v1/reference/sec/filings/X/files", expectedResponse)
+
+	var expect models.ListSECFilingFilesResponse
+	err := json.Unmarshal([]byte(expectedResponse), &expect)
+	assert.Nil(t, err)
+
+	iter := c.ListSECFilingFiles(context.Background(), &models.ListSECFilingFilesParams{
+		FilingID: "X",
+	})
+	var results []models.SECFilingFile
+	for iter.Next() {
+		results = append(results, iter.Item())
+	}
+	assert.Nil(t, iter.Err())
+	assert.ElementsMatch(t, expect.Results, results)
+}
@@ -0,0 +1,35 @@
+package massive_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSECFilingFile(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.massive.com/v1/reference/sec/filings/0001326801-19-000037/files/1",
+		httpmock.NewStringResponder(200, "<xbrl>...</xbrl>").HeaderAdd(http.Header{"Content-Type": []string{"application/xml"}}))
+
+	body, err := c.GetSECFilingFile(context.Background(), &models.GetSECFilingFileParams{
+		FilingID: "0001326801-19-000037",
+		FileID:   "1",
+	})
+	require.Nil(t, err)
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Equal(t, "<xbrl>...</xbrl>", string(data))
+}
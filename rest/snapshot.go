@@ -41,11 +41,11 @@ type SnapshotClient struct {
 //		return iter.Err()
 //	}
 func (ac *SnapshotClient) ListOptionsChainSnapshot(ctx context.Context, params *models.ListOptionsChainParams, options ...models.RequestOption) *iter.Iter[models.OptionContractSnapshot] {
-	return iter.NewIter(ctx, ListOptionsChainSnapshotPath, params, func(uri string) (iter.ListResponse, []models.OptionContractSnapshot, error) {
+	return iter.NewIter(ctx, ListOptionsChainSnapshotPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.OptionContractSnapshot, error) {
 		res := &models.ListOptionsChainSnapshotResponse{}
-		err := ac.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := ac.CallPage(ctx, http.MethodGet, ListOptionsChainSnapshotPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetAllTickersSnapshot gets the current minute, day, and previous day's aggregate, as well as the last trade and quote
@@ -134,9 +134,9 @@ func (ac *SnapshotClient) ListAssetSnapshots(ctx context.Context, params *models
 //		return iter.Err()
 //	}
 func (ac *SnapshotClient) ListUniversalSnapshots(ctx context.Context, params *models.ListUniversalSnapshotsParams, options ...models.RequestOption) *iter.Iter[models.SnapshotResponseModel] {
-	return iter.NewIter(ctx, ListUniversalSnapshotsPath, params, func(uri string) (iter.ListResponse, []models.SnapshotResponseModel, error) {
+	return iter.NewIter(ctx, ListUniversalSnapshotsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.SnapshotResponseModel, error) {
 		res := &models.ListUniversalSnapshotsResponse{}
-		err := ac.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := ac.CallPage(ctx, http.MethodGet, ListUniversalSnapshotsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
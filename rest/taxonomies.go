@@ -0,0 +1,26 @@
+package massive
+
+import (
+	"context"
+
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+// GetTaxonomyIndex lists all ticker taxonomy classifications that match the params (see ListTickerTaxonomies) and
+// returns an in-memory index of them, e.g. to group the results of GetAllTickersSnapshot by revenue stream:
+//
+//	idx, err := c.VX.GetTaxonomyIndex(context.TODO(), models.ListTickerTaxonomiesParams{}.WithCategory("revenue_streams"))
+//	if err != nil {
+//		return err
+//	}
+//	groups := idx.GroupSnapshots("revenue_streams", snapshots.Tickers)
+//
+// Note: this method utilizes an experimental API and could experience breaking changes or deprecation.
+func (c *VXClient) GetTaxonomyIndex(ctx context.Context, params *models.ListTickerTaxonomiesParams, options ...models.RequestOption) (*models.TaxonomyIndex, error) {
+	classifications, err := iter.Collect(c.ListTickerTaxonomies(ctx, params, options...).All())
+	if err != nil {
+		return nil, err
+	}
+	return models.NewTaxonomyIndex(classifications...), nil
+}
@@ -0,0 +1,36 @@
+// Code generated by restgen from .massive/rest.json; DO NOT EDIT.
+
+package massive
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/massive-com/client-go/v2/rest/iter"
+	"github.com/massive-com/client-go/v2/rest/models"
+)
+
+const (
+	ListTickerTaxonomiesPath = "/vX/reference/tickers/taxonomies"
+)
+
+// ListTickerTaxonomies retrieves taxonomy classifications (e.g. revenue streams) of tickers.
+//
+// Note: this method utilizes an experimental API and could experience breaking changes or deprecation.
+//
+// This method returns an iterator that should be used to access the results via this pattern:
+//
+//	iter := c.ListTickerTaxonomies(context.TODO(), params, opts...)
+//	for iter.Next() {
+//		log.Print(iter.Item()) // do something with the current value
+//	}
+//	if iter.Err() != nil {
+//		return iter.Err()
+//	}
+func (c *VXClient) ListTickerTaxonomies(ctx context.Context, params *models.ListTickerTaxonomiesParams, options ...models.RequestOption) *iter.Iter[models.TickerTaxonomy] {
+	return iter.NewIter(ctx, ListTickerTaxonomiesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.TickerTaxonomy, error) {
+		res := &models.ListTickerTaxonomiesResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListTickerTaxonomiesPath, uri, res, options...)
+		return res, res.Results, err
+	}, options...)
+}
@@ -0,0 +1,54 @@
+// Code generated by restgen from .massive/rest.json; DO NOT EDIT.
+
+package massive_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTickerTaxonomies(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	expectedResponse := `{
+		"request_id": "a4f9947955398c28905337f003bfee7c",
+		"results": [
+			{
+				"category": "revenue_streams",
+				"reason": "The text mentions revenue earned from the sale of digital goods or products, such as software licenses, e-books, music downloads, or digital media content.",
+				"tag": "digital_product_sales",
+				"ticker": "AAPL"
+			},
+			{
+				"category": "revenue_streams",
+				"reason": "The text mentions revenue generated from the licensing of intellectual property rights to third parties, including franchise rights, patent licensing, brand licensing, and the receipt of royalties for authorized use of intellectual property like music royalties, book royalties, or patent royalties.",
+				"tag": "licensing_and_royalties",
+				"ticker": "AAPL"
+			}
+		],
+		"status": "OK"
+	}`
+
+	registerResponder("https://api.massive.com/vX/reference/tickers/taxonomies", expectedResponse)
+
+	var expect models.ListTickerTaxonomiesResponse
+	err := json.Unmarshal([]byte(expectedResponse), &expect)
+	assert.Nil(t, err)
+
+	iter := c.VX.ListTickerTaxonomies(context.Background(), &models.ListTickerTaxonomiesParams{})
+	var results []models.TickerTaxonomy
+	for iter.Next() {
+		results = append(results, iter.Item())
+	}
+	assert.Nil(t, iter.Err())
+	assert.ElementsMatch(t, expect.Results, results)
+}
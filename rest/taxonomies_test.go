@@ -0,0 +1,42 @@
+package massive_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	massive "github.com/massive-com/client-go/v2/rest"
+	"github.com/massive-com/client-go/v2/rest/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTaxonomyIndex(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder("https://api.massive.com/vX/reference/tickers/taxonomies?category=revenue_streams", `{
+		"status": "OK",
+		"request_id": "a4f9947955398c28905337f003bfee7c",
+		"next_url": "https://api.massive.com/vX/reference/tickers/taxonomies?cursor=YWN0aXZlPXRydWU",
+		"results": [
+			{"category": "revenue_streams", "tag": "product_sales", "ticker": "AAPL"},
+			{"category": "revenue_streams", "tag": "subscription_fees", "ticker": "AAPL"}
+		]
+	}`)
+	registerResponder("https://api.massive.com/vX/reference/tickers/taxonomies?cursor=YWN0aXZlPXRydWU", `{
+		"status": "OK",
+		"request_id": "b5f9947955398c28905337f003bfee7d",
+		"results": [
+			{"category": "revenue_streams", "tag": "subscription_fees", "ticker": "MSFT"}
+		]
+	}`)
+
+	idx, err := c.VX.GetTaxonomyIndex(context.Background(), models.ListTickerTaxonomiesParams{}.WithCategory("revenue_streams"))
+	require.Nil(t, err)
+	assert.Equal(t, 3, idx.Len())
+	assert.Equal(t, []string{"AAPL", "MSFT"}, idx.Tickers("revenue_streams", "subscription_fees"))
+	assert.Equal(t, []string{"product_sales", "subscription_fees"}, idx.Tags("AAPL", "revenue_streams"))
+}
@@ -3,6 +3,7 @@ package massive
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/massive-com/client-go/v2/rest/client"
 	"github.com/massive-com/client-go/v2/rest/iter"
@@ -13,6 +14,17 @@ const (
 	ListTradesPath         = "/v3/trades/{ticker}"
 	GetLastTradePath       = "/v2/last/trade/{ticker}"
 	GetLastCryptoTradePath = "/v1/last/crypto/{from}/{to}"
+
+	ListHistoricTradesPath       = "/v2/ticks/stocks/trades/{ticker}/{date}"
+	ListHistoricCryptoTradesPath = "/v1/historic/crypto/{from}/{to}/{date}"
+)
+
+const (
+	// historicTicksDefaultLimit is the default page size of the legacy v2 ticks API.
+	historicTicksDefaultLimit = 5000
+	// historicTicksMaxLimit is the maximum page size of the legacy v1 historic API, which doesn't document its
+	// default. Its iterators request it unless a limit is set, so that they know when they reach the last page.
+	historicTicksMaxLimit = 10000
 )
 
 // TradesClient defines a REST client for the Massive trades API.
@@ -33,11 +45,21 @@ type TradesClient struct {
 //		return iter.Err()
 //	}
 func (c *TradesClient) ListTrades(ctx context.Context, params *models.ListTradesParams, options ...models.RequestOption) *iter.Iter[models.Trade] {
-	return iter.NewIter(ctx, ListTradesPath, params, func(uri string) (iter.ListResponse, []models.Trade, error) {
+	return iter.NewIter(ctx, ListTradesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.Trade, error) {
+		res := &models.ListTradesResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListTradesPath, uri, res, options...)
+		return res, res.Results, err
+	}, options...)
+}
+
+// ListTradesSharded is like ListTrades but splits the timestamp range of the params into shards that are fetched
+// concurrently (see iter.NewShardedIter).
+func (c *TradesClient) ListTradesSharded(ctx context.Context, params *models.ListTradesParams, shards, workers int, options ...models.RequestOption) *iter.Iter[models.Trade] {
+	return iter.NewShardedIter(ctx, ListTradesPath, params, shards, workers, func(ctx context.Context, uri string) (iter.ListResponse, []models.Trade, error) {
 		res := &models.ListTradesResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListTradesPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetLastTrade retrieves the last trade for a specified ticker. For more details see
@@ -55,3 +77,60 @@ func (c *TradesClient) GetLastCryptoTrade(ctx context.Context, params *models.Ge
 	err := c.Call(ctx, http.MethodGet, GetLastCryptoTradePath, params, res, options...)
 	return res, err
 }
+
+// ListHistoricTrades retrieves the trades of a ticker on a given day from the legacy v2 ticks API, which pages
+// through results by timestamp offset rather than next URLs. Each page starts at the timestamp where the page before
+// it ends (see iter.OffsetPage). The API deprecated this endpoint in favor of ListTrades.
+//
+// This method returns an iterator that should be used to access the results via this pattern:
+//
+//	iter := c.ListHistoricTrades(context.TODO(), params, opts...)
+//	for iter.Next() {
+//		log.Print(iter.Item()) // do something with the current value
+//	}
+//	if iter.Err() != nil {
+//		return iter.Err()
+//	}
+func (c *TradesClient) ListHistoricTrades(ctx context.Context, params *models.ListHistoricTradesParams, options ...models.RequestOption) *iter.Iter[models.LastTrade] {
+	return iter.NewIter(ctx, ListHistoricTradesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.LastTrade, error) {
+		res := &models.ListHistoricTradesResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListHistoricTradesPath, uri, res, options...)
+		if err != nil {
+			return res, res.Results, err
+		}
+		res.NextURL, res.Results, err = iter.OffsetPage(uri, "timestamp", historicTicksDefaultLimit, res.Results, func(t models.LastTrade) int64 {
+			return time.Time(t.Timestamp).UnixNano()
+		})
+		return res, res.Results, err
+	}, options...)
+}
+
+// ListHistoricCryptoTrades retrieves the trades of a crypto pair on a given day from the legacy v1 historic API,
+// which pages through results by timestamp offset rather than next URLs. Each page starts at the timestamp where the
+// page before it ends (see iter.OffsetPage). The API deprecated this endpoint in favor of ListTrades.
+//
+// This method returns an iterator that should be used to access the results via this pattern:
+//
+//	iter := c.ListHistoricCryptoTrades(context.TODO(), params, opts...)
+//	for iter.Next() {
+//		log.Print(iter.Item()) // do something with the current value
+//	}
+//	if iter.Err() != nil {
+//		return iter.Err()
+//	}
+func (c *TradesClient) ListHistoricCryptoTrades(ctx context.Context, params *models.ListHistoricCryptoTradesParams, options ...models.RequestOption) *iter.Iter[models.CryptoTick] {
+	if params != nil && params.Limit == nil {
+		params = params.WithLimit(historicTicksMaxLimit)
+	}
+	return iter.NewIter(ctx, ListHistoricCryptoTradesPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.CryptoTick, error) {
+		res := &models.ListHistoricCryptoTradesResponse{}
+		err := c.CallPage(ctx, http.MethodGet, ListHistoricCryptoTradesPath, uri, res, options...)
+		if err != nil {
+			return res, res.Ticks, err
+		}
+		res.NextURL, res.Ticks, err = iter.OffsetPage(uri, "offset", historicTicksMaxLimit, res.Ticks, func(t models.CryptoTick) int64 {
+			return time.Time(t.Timestamp).UnixMilli()
+		})
+		return res, res.Ticks, err
+	}, options...)
+}
@@ -161,3 +161,155 @@ func TestGetLastCryptoTrade(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, &expect, res)
 }
+
+func TestListTradesSharded(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	// the first shard has two pages and the second one has no trades
+	registerResponder("https://api.massive.com/v3/trades/AAPL?timestamp.gte=1626948000000000000&timestamp.lt=1626951600000000000",
+		`{"status":"OK","results":[{"id":"1"}],"next_url":"https://api.massive.com/v3/trades/AAPL?cursor=NEXT"}`)
+	registerResponder("https://api.massive.com/v3/trades/AAPL?cursor=NEXT", `{"status":"OK","results":[{"id":"2"}]}`)
+	registerResponder("https://api.massive.com/v3/trades/AAPL?timestamp.gte=1626951600000000000&timestamp.lt=1626955200000000000",
+		`{"status":"OK","results":[]}`)
+	registerResponder("https://api.massive.com/v3/trades/AAPL?timestamp.gte=1626955200000000000&timestamp.lte=1626958800000000000",
+		`{"status":"OK","results":[{"id":"3"},{"id":"4"}]}`)
+
+	start := time.Date(2021, 7, 22, 10, 0, 0, 0, time.UTC)
+	params := models.ListTradesParams{Ticker: "AAPL"}.
+		WithTimestamp(models.GTE, models.Nanos(start)).
+		WithTimestamp(models.LTE, models.Nanos(start.Add(3*time.Hour)))
+	iter := c.ListTradesSharded(context.Background(), params, 3, 2)
+
+	var ids []string
+	for iter.Next() {
+		ids = append(ids, iter.Item().ID)
+	}
+	assert.Nil(t, iter.Err())
+	assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+func TestListTradesShardedUnbounded(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	iter := c.ListTradesSharded(context.Background(), models.ListTradesParams{Ticker: "AAPL"}.
+		WithTimestamp(models.GTE, models.Nanos(time.Now())), 3, 2)
+	assert.False(t, iter.Next())
+	assert.ErrorIs(t, iter.Err(), models.ErrUnboundedRange)
+}
+
+func TestListHistoricTrades(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder("https://api.massive.com/v2/ticks/stocks/trades/AAPL/2020-10-14?limit=3", `{
+	"ticker": "AAPL",
+	"success": true,
+	"results_count": 3,
+	"db_latency": 11,
+	"results": [
+		{"c": [12, 41], "i": "1", "p": 171.55, "q": 1063, "s": 100, "t": 1517562000016036600, "x": 11, "y": 1517562000015577000, "z": 3},
+		{"c": [12, 41], "i": "2", "p": 171.55, "q": 1064, "s": 100, "t": 1517562000016038100, "x": 11, "y": 1517562000015577600, "z": 3},
+		{"c": [12, 41], "i": "3", "p": 171.55, "q": 1065, "s": 100, "t": 1517562000016038100, "x": 11, "y": 1517562000015577600, "z": 3}
+	]
+}`)
+	// the offset is inclusive, so the page starts with the trades at the timestamp where the one before it ended
+	registerResponder("https://api.massive.com/v2/ticks/stocks/trades/AAPL/2020-10-14?limit=3&timestamp=1517562000016038100", `{
+	"ticker": "AAPL",
+	"success": true,
+	"results_count": 3,
+	"results": [
+		{"c": [12, 41], "i": "2", "p": 171.55, "q": 1064, "s": 100, "t": 1517562000016038100, "x": 11, "y": 1517562000015577600, "z": 3},
+		{"c": [12, 41], "i": "3", "p": 171.55, "q": 1065, "s": 100, "t": 1517562000016038100, "x": 11, "y": 1517562000015577600, "z": 3},
+		{"c": [37], "i": "4", "p": 171.6, "q": 1066, "s": 5, "t": 1517562000016039000, "x": 4, "y": 1517562000015578000, "z": 3}
+	]
+}`)
+	registerResponder("https://api.massive.com/v2/ticks/stocks/trades/AAPL/2020-10-14?limit=3&timestamp=1517562000016039000", `{
+	"ticker": "AAPL",
+	"success": true,
+	"results_count": 1,
+	"results": [
+		{"c": [37], "i": "4", "p": 171.6, "q": 1066, "s": 5, "t": 1517562000016039000, "x": 4, "y": 1517562000015578000, "z": 3}
+	]
+}`)
+
+	params := models.ListHistoricTradesParams{
+		Ticker: "AAPL",
+		Date:   models.Date(time.Date(2020, 10, 14, 0, 0, 0, 0, time.UTC)),
+	}.WithLimit(3)
+	var ids []string
+	iter := c.ListHistoricTrades(context.Background(), params)
+	for iter.Next() {
+		ids = append(ids, iter.Item().ID)
+	}
+	assert.Nil(t, iter.Err())
+	assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestListHistoricTradesSameTimestamp(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	registerResponder("https://api.massive.com/v2/ticks/stocks/trades/AAPL/2020-10-14?limit=2", `{
+	"ticker": "AAPL",
+	"success": true,
+	"results_count": 2,
+	"results": [
+		{"c": [12, 41], "i": "1", "p": 171.55, "q": 1063, "s": 100, "t": 1517562000016038100, "x": 11, "y": 1517562000015577000, "z": 3},
+		{"c": [12, 41], "i": "2", "p": 171.55, "q": 1064, "s": 100, "t": 1517562000016038100, "x": 11, "y": 1517562000015577600, "z": 3}
+	]
+}`)
+
+	params := models.ListHistoricTradesParams{
+		Ticker: "AAPL",
+		Date:   models.Date(time.Date(2020, 10, 14, 0, 0, 0, 0, time.UTC)),
+	}.WithLimit(2)
+	iter := c.ListHistoricTrades(context.Background(), params)
+	assert.False(t, iter.Next())
+	assert.ErrorContains(t, iter.Err(), "all 2 results of the page have the timestamp 1517562000016038100")
+}
+
+func TestListHistoricCryptoTrades(t *testing.T) {
+	c := massive.New("API_KEY")
+
+	httpmock.ActivateNonDefault(c.HTTP.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	expectedResponse := `{
+	"day": "2020-10-14T00:00:00.000Z",
+	"msLatency": 1,
+	"status": "success",
+	"symbol": "BTC-USD",
+	"ticks": [
+		{"c": [2], "p": 15482.89, "s": 0.00188217, "t": 1604880000067, "x": 1},
+		{"c": [2], "p": 15482.11, "s": 0.00161739, "t": 1604880000167, "x": 1}
+	],
+	"type": "crypto"
+}`
+	registerResponder("https://api.massive.com/v1/historic/crypto/BTC/USD/2020-10-14?limit=10000", expectedResponse)
+
+	var expect models.ListHistoricCryptoTradesResponse
+	err := json.Unmarshal([]byte(expectedResponse), &expect)
+	assert.Nil(t, err)
+
+	iter := c.ListHistoricCryptoTrades(context.Background(), &models.ListHistoricCryptoTradesParams{
+		From: "BTC",
+		To:   "USD",
+		Date: models.Date(time.Date(2020, 10, 14, 0, 0, 0, 0, time.UTC)),
+	})
+	var results []models.CryptoTick
+	for iter.Next() {
+		results = append(results, iter.Item())
+	}
+	assert.Nil(t, iter.Err())
+	assert.Equal(t, expect.Ticks, results)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
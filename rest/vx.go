@@ -35,11 +35,11 @@ type VXClient struct {
 //		return iter.Err()
 //	}
 func (c *VXClient) ListStockFinancials(ctx context.Context, params *models.ListStockFinancialsParams, options ...models.RequestOption) *iter.Iter[models.StockFinancial] {
-	return iter.NewIter(ctx, ListFinancialsPath, params, func(uri string) (iter.ListResponse, []models.StockFinancial, error) {
+	return iter.NewIter(ctx, ListFinancialsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.StockFinancial, error) {
 		res := &models.ListStockFinancialsResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListFinancialsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
 
 // GetTickerEvents retrieves a timeline of events for the entity associated with the given ticker, CUSIP, or Composite FIGI.
@@ -53,9 +53,9 @@ func (c *VXClient) GetTickerEvents(ctx context.Context, params *models.GetTicker
 // ListIPOs retrieves detailed information about Initial Public Offerings (IPOs), including both upcoming and historical events.
 // Note: this method utilizes an experimental API and could experience breaking changes or deprecation.
 func (c *VXClient) ListIPOs(ctx context.Context, params *models.ListIPOsParams, options ...models.RequestOption) *iter.Iter[models.IPOResult] {
-	return iter.NewIter(ctx, ListIPOsPath, params, func(uri string) (iter.ListResponse, []models.IPOResult, error) {
+	return iter.NewIter(ctx, ListIPOsPath, params, func(ctx context.Context, uri string) (iter.ListResponse, []models.IPOResult, error) {
 		res := &models.ListIPOsResponse{}
-		err := c.CallURL(ctx, http.MethodGet, uri, res, options...)
+		err := c.CallPage(ctx, http.MethodGet, ListIPOsPath, uri, res, options...)
 		return res, res.Results, err
-	})
+	}, options...)
 }
@@ -2,6 +2,7 @@ package massivews
 
 import (
 	"errors"
+	"slices"
 )
 
 // Config is a set of WebSocket client options.
@@ -39,6 +40,10 @@ type Config struct {
 	// Log is an optional logger. Any logger implementation can be used as long as it
 	// implements the basic Logger interface. Omitting this will disable client logging.
 	Log Logger
+
+	// Metrics is an optional receiver of client metrics such as message counts and queue
+	// depths. Omitting this will disable metrics.
+	Metrics Metrics
 }
 
 func (c *Config) validate() error {
@@ -50,6 +55,10 @@ func (c *Config) validate() error {
 		c.Log = &nopLogger{}
 	}
 
+	if c.Metrics == nil {
+		c.Metrics = &nopMetrics{}
+	}
+
 	return nil
 }
 
@@ -94,159 +103,19 @@ const (
 )
 
 func (m Market) supports(topic Topic) bool {
-	// FMV is supported for Stocks, Options, Forex, and Crypto but is not within the range
-	// so we need to make sure FMV is suppored if these markets are used.
-	isBusinessFairMarketValue := topic == BusinessFairMarketValue
-
-	switch m {
-	case Stocks:
-		return isBusinessFairMarketValue || (topic > stocksMin && topic < stocksMax)
-	case Options:
-		return isBusinessFairMarketValue || (topic > optionsMin && topic < optionsMax)
-	case Forex:
-		return isBusinessFairMarketValue || (topic > forexMin && topic < forexMax)
-	case Crypto:
-		return isBusinessFairMarketValue || (topic > cryptoMin && topic < cryptoMax)
-	case Indices:
-		return topic == IndexSecAggs || topic == IndexMinAggs || topic == IndexValue
-	case Futures, FuturesCME, FuturesCBOT, FuturesNYMEX, FuturesCOMEX:
-		return topic > futuresMin && topic < futuresMax
+	topics, ok := marketTopics[m]
+	if !ok {
+		return true // assume user knows what they're doing if they use some unknown market
 	}
-
-	return true // assume user knows what they're doing if they use some unknown market
+	return slices.Contains(topics, topic)
 }
 
-// Topic is the data type used to subscribe and retrieve data from the server.
-type Topic uint8
-
+// Topic is the data type used to subscribe and retrieve data from the server. The topics are
+// generated from the WebSocket spec.
+//
 // The launchpad topics should be used for any asset class when connecting to
 // the Launchpad feed
-const (
-	stocksMin              Topic = 10
-	StocksSecAggs          Topic = 11
-	StocksMinAggs          Topic = 12
-	StocksTrades           Topic = 13
-	StocksQuotes           Topic = 14
-	StocksImbalances       Topic = 15
-	StocksLULD             Topic = 16
-	StocksLaunchpadMinAggs Topic = 17
-	StocksLaunchpadValue   Topic = 18
-	stocksMax              Topic = 19
-
-	optionsMin              Topic = 30
-	OptionsSecAggs          Topic = 31
-	OptionsMinAggs          Topic = 32
-	OptionsTrades           Topic = 33
-	OptionsQuotes           Topic = 34
-	OptionsLaunchpadMinAggs Topic = 35
-	OptionsLaunchpadValue   Topic = 36
-	optionsMax              Topic = 37
-
-	forexMin              Topic = 50
-	ForexSecAggs          Topic = 51
-	ForexMinAggs          Topic = 52
-	ForexQuotes           Topic = 53
-	ForexLaunchpadMinAggs Topic = 54
-	ForexLaunchpadValue   Topic = 55
-	forexMax              Topic = 56
-
-	cryptoMin              Topic = 70
-	CryptoSecAggs          Topic = 71
-	CryptoMinAggs          Topic = 72
-	CryptoTrades           Topic = 73
-	CryptoQuotes           Topic = 74
-	CryptoL2Book           Topic = 75
-	CryptoLaunchpadMinAggs Topic = 76
-	CryptoLaunchpadValue   Topic = 77
-	cryptoMax              Topic = 78
-
-	IndexSecAggs Topic = 90
-	IndexMinAggs Topic = 91
-	IndexValue   Topic = 92
-
-	BusinessFairMarketValue Topic = 100
-
-	futuresMin    Topic = 110
-	FutureSecAggs Topic = 111
-	FutureMinAggs Topic = 112
-	FutureTrades  Topic = 113
-	FutureQuotes  Topic = 114
-	futuresMax    Topic = 115
-)
-
-func (t Topic) prefix() string {
-	switch t {
-	case StocksSecAggs:
-		return "A"
-	case StocksMinAggs:
-		return "AM"
-	case StocksTrades:
-		return "T"
-	case StocksQuotes:
-		return "Q"
-	case StocksImbalances:
-		return "NOI"
-	case StocksLULD:
-		return "LULD"
-	case StocksLaunchpadMinAggs:
-		return "AM"
-	case StocksLaunchpadValue:
-		return "LV"
-	case OptionsSecAggs:
-		return "A"
-	case OptionsMinAggs:
-		return "AM"
-	case OptionsTrades:
-		return "T"
-	case OptionsQuotes:
-		return "Q"
-	case OptionsLaunchpadMinAggs:
-		return "AM"
-	case OptionsLaunchpadValue:
-		return "LV"
-	case ForexSecAggs:
-		return "CAS"
-	case ForexMinAggs:
-		return "CA"
-	case ForexQuotes:
-		return "C"
-	case ForexLaunchpadMinAggs:
-		return "AM"
-	case ForexLaunchpadValue:
-		return "LV"
-	case CryptoSecAggs:
-		return "XAS"
-	case CryptoMinAggs:
-		return "XA"
-	case CryptoTrades:
-		return "XT"
-	case CryptoQuotes:
-		return "XQ"
-	case CryptoL2Book:
-		return "XL2"
-	case CryptoLaunchpadMinAggs:
-		return "AM"
-	case CryptoLaunchpadValue:
-		return "LV"
-	case IndexSecAggs:
-		return "A"
-	case IndexMinAggs:
-		return "AM"
-	case IndexValue:
-		return "V"
-	case BusinessFairMarketValue:
-		return "FMV"
-	case FutureSecAggs:
-		return "A"
-	case FutureMinAggs:
-		return "AM"
-	case FutureTrades:
-		return "T"
-	case FutureQuotes:
-		return "Q"
-	}
-	return ""
-}
+type Topic uint8
 
 // Logger is a basic logger interface used for logging within the client.
 type Logger interface {
@@ -260,3 +129,29 @@ type nopLogger struct{}
 func (l *nopLogger) Debugf(template string, args ...any) {}
 func (l *nopLogger) Infof(template string, args ...any)  {}
 func (l *nopLogger) Errorf(template string, args ...any) {}
+
+// Metrics is an interface used to instrument the client's message pipeline.
+type Metrics interface {
+	// MessageReceived is called for every message that's routed by the client with its
+	// event type (e.g. "T", "AM" or "status").
+	MessageReceived(eventType string)
+
+	// QueueDepth is called after a batch of messages is taken off the read queue with the
+	// number of batches still waiting to be processed and the number of messages waiting
+	// in the output channel.
+	QueueDepth(readQueue, output int)
+
+	// Reconnected is called after each reconnect attempt. The error is non-nil if the attempt
+	// failed and is being retried.
+	Reconnected(err error)
+
+	// ParseFailed is called when a message can't be decoded.
+	ParseFailed(err error)
+}
+
+type nopMetrics struct{}
+
+func (m *nopMetrics) MessageReceived(eventType string) {}
+func (m *nopMetrics) QueueDepth(readQueue, output int) {}
+func (m *nopMetrics) Reconnected(err error)            {}
+func (m *nopMetrics) ParseFailed(err error)            {}
@@ -0,0 +1,4 @@
+package massivews
+
+// Topics and event models are generated from the WebSocket spec with the names listed in .massive/topics.json.
+//go:generate go run ../internal/cmd/wsgen -spec ../.massive/websocket.json -config ../.massive/topics.json
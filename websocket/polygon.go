@@ -50,6 +50,7 @@ type Client struct {
 
 	reconnectCallback func(error)
 	log               Logger
+	metrics           Metrics
 }
 
 // New creates a client for the Massive WebSocket API.
@@ -71,6 +72,7 @@ func New(config Config) (*Client, error) {
 		output:               make(chan any, 100000),
 		err:                  make(chan error),
 		log:                  config.Log,
+		metrics:              config.Metrics,
 		reconnectCallback:    config.ReconnectCallback,
 	}
 
@@ -248,6 +250,7 @@ func (c *Client) reconnect() {
 
 	notify := func(err error, _ time.Duration) {
 		c.log.Errorf(err.Error())
+		c.metrics.Reconnected(err)
 		if c.reconnectCallback != nil {
 			c.reconnectCallback(err)
 		}
@@ -260,6 +263,7 @@ func (c *Client) reconnect() {
 		c.err <- err
 	} else {
 		// Callback on success.
+		c.metrics.Reconnected(nil)
 		if c.reconnectCallback != nil {
 			c.reconnectCallback(nil)
 		}
@@ -368,6 +372,7 @@ func (c *Client) process() (err error) {
 		case <-c.ptomb.Dying():
 			return nil
 		case data := <-c.rQueue:
+			c.metrics.QueueDepth(len(c.rQueue), len(c.output))
 			if c.rawData && c.bypassRawDataRouting {
 				c.output <- data // push raw bytes to output channel
 				continue
@@ -376,6 +381,7 @@ func (c *Client) process() (err error) {
 			var msgs []json.RawMessage
 			if err := json.Unmarshal(data, &msgs); err != nil {
 				c.log.Errorf("failed to process raw messages: %v", err)
+				c.metrics.ParseFailed(err)
 				continue
 			}
 			if err := c.route(msgs); err != nil {
@@ -391,9 +397,11 @@ func (c *Client) route(msgs []json.RawMessage) error {
 		err := json.Unmarshal(msg, &ev)
 		if err != nil {
 			c.log.Errorf("failed to process message: %v", err)
+			c.metrics.ParseFailed(err)
 			continue
 		}
 
+		c.metrics.MessageReceived(ev.EventType)
 		switch ev.EventType {
 		case "status":
 			if err := c.handleStatus(msg); err != nil {
@@ -411,6 +419,7 @@ func (c *Client) handleStatus(msg json.RawMessage) error {
 	var cm models.ControlMessage
 	if err := json.Unmarshal(msg, &cm); err != nil {
 		c.log.Errorf("failed to unmarshal message: %v", err)
+		c.metrics.ParseFailed(err)
 		return nil
 	}
 
@@ -446,6 +455,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.EquityAgg
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -453,6 +463,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.EquityTrade
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -460,6 +471,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.EquityQuote
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -467,6 +479,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.LimitUpLimitDown
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -474,6 +487,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.Imbalance
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -481,6 +495,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.FairMarketValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -488,6 +503,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.LaunchpadValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -500,6 +516,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.EquityAgg
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -507,6 +524,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.EquityTrade
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -514,6 +532,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.EquityQuote
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -521,6 +540,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.FairMarketValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -528,6 +548,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.LaunchpadValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -540,6 +561,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.CurrencyAgg
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -547,6 +569,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.ForexQuote
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -554,6 +577,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.FairMarketValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -561,6 +585,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.LaunchpadValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -573,6 +598,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.CurrencyAgg
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -580,6 +606,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.CryptoTrade
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -587,6 +614,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.CryptoQuote
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -594,6 +622,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.Level2Book
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -601,6 +630,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.FairMarketValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -608,6 +638,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.LaunchpadValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -620,6 +651,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.EquityAgg
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -627,6 +659,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.IndexValue
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -639,6 +672,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.FuturesAggregate
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -646,6 +680,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.FuturesTrade
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
@@ -653,6 +688,7 @@ func (c *Client) handleData(eventType string, msg json.RawMessage) {
 			var out models.FuturesQuote
 			if err := json.Unmarshal(msg, &out); err != nil {
 				c.log.Errorf("failed to unmarshal message: %v", err)
+				c.metrics.ParseFailed(err)
 				return
 			}
 			c.output <- out
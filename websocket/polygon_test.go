@@ -181,3 +181,36 @@ func TestReconnectCallback(t *testing.T) {
 	c.Close()
 	assert.Equal(t, 1, reconnectCallbackCount)
 }
+
+type countingMetrics struct {
+	messages     map[string]int
+	reconnects   int
+	parseFailure int
+}
+
+func (m *countingMetrics) MessageReceived(eventType string) { m.messages[eventType]++ }
+func (m *countingMetrics) QueueDepth(readQueue, output int) {}
+func (m *countingMetrics) Reconnected(err error)            { m.reconnects++ }
+func (m *countingMetrics) ParseFailed(err error)            { m.parseFailure++ }
+
+func TestMetrics(t *testing.T) {
+	metrics := &countingMetrics{messages: map[string]int{}}
+	c, err := New(Config{
+		APIKey:  "good",
+		Feed:    RealTime,
+		Market:  Stocks,
+		Metrics: metrics,
+	})
+	assert.Nil(t, err)
+
+	err = c.route([]json.RawMessage{
+		json.RawMessage(`{"ev":"T","sym":"AAPL","p":150.1}`),
+		json.RawMessage(`{"ev":"T","sym":"AAPL","p":"bad"}`),
+		json.RawMessage(`{"ev":"Q","sym":"AAPL"}`),
+		json.RawMessage(`not json`),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"T": 2, "Q": 1}, metrics.messages)
+	assert.Equal(t, 2, metrics.parseFailure)
+	assert.Len(t, c.output, 2)
+}
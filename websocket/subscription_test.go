@@ -10,13 +10,13 @@ import (
 
 func TestSupportsTopic(t *testing.T) {
 	assert.Equal(t, true, Stocks.supports(StocksMinAggs))
-	assert.Equal(t, false, Stocks.supports(stocksMax))
+	assert.Equal(t, false, Stocks.supports(CryptoTrades))
 	assert.Equal(t, true, Options.supports(OptionsSecAggs))
 	assert.Equal(t, false, Options.supports(StocksMinAggs))
 	assert.Equal(t, true, Forex.supports(ForexQuotes))
 	assert.Equal(t, false, Forex.supports(OptionsQuotes))
 	assert.Equal(t, true, Crypto.supports(CryptoL2Book))
-	assert.Equal(t, false, Crypto.supports(cryptoMin))
+	assert.Equal(t, false, Crypto.supports(StocksTrades))
 	assert.Equal(t, true, Market("testMarket").supports(StocksImbalances))
 }
 
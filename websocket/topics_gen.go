@@ -0,0 +1,197 @@
+// Code generated by wsgen from .massive/websocket.json; DO NOT EDIT.
+
+package massivews
+
+const (
+	StocksSecAggs          Topic = 11
+	StocksMinAggs          Topic = 12
+	StocksTrades           Topic = 13
+	StocksQuotes           Topic = 14
+	StocksImbalances       Topic = 15
+	StocksLULD             Topic = 16
+	StocksLaunchpadMinAggs Topic = 17
+	StocksLaunchpadValue   Topic = 18
+
+	OptionsSecAggs          Topic = 31
+	OptionsMinAggs          Topic = 32
+	OptionsTrades           Topic = 33
+	OptionsQuotes           Topic = 34
+	OptionsLaunchpadMinAggs Topic = 35
+	OptionsLaunchpadValue   Topic = 36
+
+	ForexSecAggs          Topic = 51
+	ForexMinAggs          Topic = 52
+	ForexQuotes           Topic = 53
+	ForexLaunchpadMinAggs Topic = 54
+	ForexLaunchpadValue   Topic = 55
+
+	CryptoSecAggs          Topic = 71
+	CryptoMinAggs          Topic = 72
+	CryptoTrades           Topic = 73
+	CryptoQuotes           Topic = 74
+	CryptoL2Book           Topic = 75
+	CryptoLaunchpadMinAggs Topic = 76
+	CryptoLaunchpadValue   Topic = 77
+
+	IndexSecAggs Topic = 90
+	IndexMinAggs Topic = 91
+	IndexValue   Topic = 92
+
+	BusinessFairMarketValue Topic = 100
+
+	FutureSecAggs Topic = 111
+	FutureMinAggs Topic = 112
+	FutureTrades  Topic = 113
+	FutureQuotes  Topic = 114
+)
+
+func (t Topic) prefix() string {
+	switch t {
+	case StocksSecAggs:
+		return "A"
+	case StocksMinAggs:
+		return "AM"
+	case StocksTrades:
+		return "T"
+	case StocksQuotes:
+		return "Q"
+	case StocksImbalances:
+		return "NOI"
+	case StocksLULD:
+		return "LULD"
+	case StocksLaunchpadMinAggs:
+		return "AM"
+	case StocksLaunchpadValue:
+		return "LV"
+	case OptionsSecAggs:
+		return "A"
+	case OptionsMinAggs:
+		return "AM"
+	case OptionsTrades:
+		return "T"
+	case OptionsQuotes:
+		return "Q"
+	case OptionsLaunchpadMinAggs:
+		return "AM"
+	case OptionsLaunchpadValue:
+		return "LV"
+	case ForexSecAggs:
+		return "CAS"
+	case ForexMinAggs:
+		return "CA"
+	case ForexQuotes:
+		return "C"
+	case ForexLaunchpadMinAggs:
+		return "AM"
+	case ForexLaunchpadValue:
+		return "LV"
+	case CryptoSecAggs:
+		return "XAS"
+	case CryptoMinAggs:
+		return "XA"
+	case CryptoTrades:
+		return "XT"
+	case CryptoQuotes:
+		return "XQ"
+	case CryptoL2Book:
+		return "XL2"
+	case CryptoLaunchpadMinAggs:
+		return "AM"
+	case CryptoLaunchpadValue:
+		return "LV"
+	case IndexSecAggs:
+		return "A"
+	case IndexMinAggs:
+		return "AM"
+	case IndexValue:
+		return "V"
+	case BusinessFairMarketValue:
+		return "FMV"
+	case FutureSecAggs:
+		return "A"
+	case FutureMinAggs:
+		return "AM"
+	case FutureTrades:
+		return "T"
+	case FutureQuotes:
+		return "Q"
+	}
+	return ""
+}
+
+// marketTopics are the topics that each market supports.
+var marketTopics = map[Market][]Topic{
+	Stocks: {
+		StocksSecAggs,
+		StocksMinAggs,
+		StocksTrades,
+		StocksQuotes,
+		StocksImbalances,
+		StocksLULD,
+		StocksLaunchpadMinAggs,
+		StocksLaunchpadValue,
+		BusinessFairMarketValue,
+	},
+	Options: {
+		OptionsSecAggs,
+		OptionsMinAggs,
+		OptionsTrades,
+		OptionsQuotes,
+		OptionsLaunchpadMinAggs,
+		OptionsLaunchpadValue,
+		BusinessFairMarketValue,
+	},
+	Forex: {
+		ForexSecAggs,
+		ForexMinAggs,
+		ForexQuotes,
+		ForexLaunchpadMinAggs,
+		ForexLaunchpadValue,
+		BusinessFairMarketValue,
+	},
+	Crypto: {
+		CryptoSecAggs,
+		CryptoMinAggs,
+		CryptoTrades,
+		CryptoQuotes,
+		CryptoL2Book,
+		CryptoLaunchpadMinAggs,
+		CryptoLaunchpadValue,
+		BusinessFairMarketValue,
+	},
+	Indices: {
+		IndexSecAggs,
+		IndexMinAggs,
+		IndexValue,
+	},
+	Futures: {
+		FutureSecAggs,
+		FutureMinAggs,
+		FutureTrades,
+		FutureQuotes,
+	},
+	FuturesCME: {
+		FutureSecAggs,
+		FutureMinAggs,
+		FutureTrades,
+		FutureQuotes,
+	},
+	FuturesCBOT: {
+		FutureSecAggs,
+		FutureMinAggs,
+		FutureTrades,
+		FutureQuotes,
+	},
+	FuturesNYMEX: {
+		FutureSecAggs,
+		FutureMinAggs,
+		FutureTrades,
+		FutureQuotes,
+	},
+	FuturesCOMEX: {
+		FutureSecAggs,
+		FutureMinAggs,
+		FutureTrades,
+		FutureQuotes,
+	},
+}